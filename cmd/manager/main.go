@@ -4,13 +4,22 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
+	"os"
 	"runtime"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/openshift/gcp-project-operator/pkg/apis"
+	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/condition"
 	"github.com/openshift/gcp-project-operator/pkg/controller"
+	"github.com/openshift/gcp-project-operator/pkg/controller/projectclaim"
+	"github.com/openshift/gcp-project-operator/pkg/controller/projectreference"
+	"github.com/openshift/gcp-project-operator/pkg/events"
 
 	"github.com/operator-framework/operator-sdk/pkg/leader"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
@@ -28,6 +37,8 @@ import (
 var (
 	metricsHost       = "0.0.0.0"
 	metricsPort int32 = 8383
+	// debugAddr serves operator debug endpoints such as finalization progress
+	debugAddr = "127.0.0.1:8384"
 )
 var log = logf.Log.WithName("cmd")
 
@@ -52,8 +63,87 @@ func run() error {
 	// controller-runtime)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 
+	watchNamespaces := pflag.String("watch-namespaces", "", "Comma-separated list of namespaces in which ProjectClaims are reconciled. Claims in other namespaces are ignored. Watches all namespaces if empty.")
+	validateOnly := pflag.Bool("validate-only", false, "Run both controllers in validate-only mode: validations run and conditions are reported, but nothing is changed in GCP and no ProjectReferences are created or modified.")
+	finalizationBackoffCap := pflag.Duration("finalization-backoff-cap", 10*time.Minute, "Maximum delay between retries of a failing finalization (GCP deletion). Retries back off exponentially up to this cap.")
+	eventDedupInterval := pflag.Duration("event-dedup-interval", time.Minute, "Minimum time before an identical event (same object, reason and message) is emitted again. Suppressed occurrences are counted.")
+	operatorImpersonationMember := pflag.String("operator-impersonation-member", "", "IAM member (e.g. serviceAccount:operator@project.iam.gserviceaccount.com) granted roles/iam.serviceAccountTokenCreator on each created service account, for keyless operation. Disabled if empty.")
+	referenceAnnotationTemplates := pflag.String("reference-annotation-templates", "", "Comma-separated annotation=template pairs rendered onto new ProjectReferences from their claim (e.g. team={{.Spec.Labels.team}}). Templates referencing fields a claim does not set are skipped for that claim.")
+	claimAnnotationConditions := pflag.String("claim-annotation-conditions", "", "Comma-separated annotation=conditionType pairs mirrored from claim annotations to claim conditions (e.g. example.com/approved=Approved). The annotations are only read, never written.")
+	claimFinalizerName := pflag.String("claim-finalizer-name", projectclaim.ProjectClaimFinalizer, "Finalizer name placed on ProjectClaims. Override it to coexist with an older operator using a different finalizer string during migrations.")
+	skipDefaultNetwork := pflag.Bool("skip-default-network", false, "Enable SkipDefaultNetwork for every claim that does not request it itself: enforce the compute.skipDefaultNetworkCreation org policy on new projects and delete the auto-created default network.")
+	reconcileInvariants := pflag.Bool("reconcile-invariants", false, "Run the reconcile operations a second time after every clean pass and log any operation that still reports mutations. Doubles the work of each reconcile; intended for catching non-idempotent Ensure methods in test environments.")
+	debugReconciles := pflag.Bool("debug-reconciles", false, "Record recent reconcile decisions (per claim: which operations ran, their results and errors) in an in-memory ring buffer served at /debug/reconciles on the debug endpoint.")
+	reconcileIAMDrift := pflag.Bool("reconcile-iam-drift", false, "Re-apply the operator-managed IAM bindings when drift is detected on a Ready project, instead of only reporting it via the IAMDrift condition.")
+	readyRequeueInterval := pflag.Duration("ready-requeue-interval", 30*time.Minute, "How often Ready ProjectReferences re-reconcile so the ready-path checks (such as IAM drift detection) keep running.")
+	readyRequeueJitter := pflag.Float64("ready-requeue-jitter", 0.1, "Fraction of the ready requeue interval used as per-object random jitter, spreading re-reconciles across interval ± interval*jitter.")
+	projectCreateTimeout := pflag.Duration("project-create-timeout", 30*time.Minute, "Deadline for a GCP project to finish creation, measured from the creation of its ProjectReference. Exceeding it raises the ProjectCreateTimeout condition.")
+	reconcileTimeout := pflag.Duration("reconcile-timeout", 2*time.Minute, "Deadline for a single ProjectReference reconcile pass. A reconcile exceeding it releases its worker and is requeued instead of blocking the queue.")
+	drainAccessRevokeAfter := pflag.Duration("drain-access-revoke-after", 0, "How long after a ProjectClaim starts draining its copied credential Secret is deleted, revoking consumer access. Revocation is disabled if zero.")
+	requiredLabels := pflag.String("required-labels", "", "Comma-separated label keys (e.g. team,cost-center) every ProjectClaim must carry. Claims missing any of them are held in Error state until the labels are added. No labels are required if empty.")
+	conditionMessageMaxLength := pflag.Int("condition-message-max-length", 1024, "Maximum length of condition messages. Longer messages, such as raw GCP error responses, are truncated with an ellipsis to keep objects small.")
+	maxProjectsPerLegalEntity := pflag.Int("max-projects-per-legal-entity", 0, "Maximum number of projects a single legal entity may have. Claims over the limit are blocked with the ProjectLimitReached condition until a project is deleted or the limit is raised. Unlimited if zero.")
+
 	pflag.Parse()
 
+	if *watchNamespaces != "" {
+		projectclaim.SetWatchNamespaces(strings.Split(*watchNamespaces, ","))
+	}
+	if *validateOnly {
+		projectclaim.SetValidateOnly(true)
+		projectreference.SetValidateOnly(true)
+	}
+	projectreference.SetFinalizationBackoffCap(*finalizationBackoffCap)
+	events.SetDedupInterval(*eventDedupInterval)
+	projectreference.SetOperatorImpersonationMember(*operatorImpersonationMember)
+	projectclaim.SetProjectClaimFinalizerName(*claimFinalizerName)
+	projectclaim.SetReconcileInvariants(*reconcileInvariants)
+	projectclaim.SetSkipDefaultNetworkDefault(*skipDefaultNetwork)
+	projectclaim.SetReconcileDebug(*debugReconciles)
+	projectreference.SetIAMDriftRepair(*reconcileIAMDrift)
+	projectreference.SetProjectCreateTimeout(*projectCreateTimeout)
+	projectreference.SetReconcileTimeout(*reconcileTimeout)
+	projectreference.SetReadyRequeue(*readyRequeueInterval, *readyRequeueJitter)
+	projectclaim.SetDrainAccessRevokeAfter(*drainAccessRevokeAfter)
+	if *requiredLabels != "" {
+		projectclaim.SetRequiredLabels(strings.Split(*requiredLabels, ","))
+	}
+	condition.SetMessageMaxLength(*conditionMessageMaxLength)
+	projectclaim.SetMaxProjectsPerLegalEntity(*maxProjectsPerLegalEntity)
+	// In HA deployments each operator instance stamps its identity on the
+	// claims it reconciles. OPERATOR_INSTANCE is typically wired to the pod
+	// name via the downward API; the hostname equals the pod name and serves
+	// as a fallback.
+	identity := os.Getenv("OPERATOR_INSTANCE")
+	if identity == "" {
+		identity, _ = os.Hostname()
+	}
+	projectclaim.SetOperatorIdentity(identity)
+	if *referenceAnnotationTemplates != "" {
+		templates := map[string]string{}
+		for _, pair := range strings.Split(*referenceAnnotationTemplates, ",") {
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid reference-annotation-templates entry %q, expected annotation=template", pair)
+			}
+			templates[parts[0]] = parts[1]
+		}
+		if err := projectclaim.SetReferenceAnnotationTemplates(templates); err != nil {
+			return err
+		}
+	}
+	if *claimAnnotationConditions != "" {
+		mapping := map[string]gcpv1alpha1.ConditionType{}
+		for _, pair := range strings.Split(*claimAnnotationConditions, ",") {
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid claim-annotation-conditions entry %q, expected annotation=conditionType", pair)
+			}
+			mapping[parts[0]] = gcpv1alpha1.ConditionType(parts[1])
+		}
+		projectclaim.SetAnnotationConditions(mapping)
+	}
+
 	// Use a zap logr.Logger implementation. If none of the zap
 	// flags are configured (or if the zap flag set is not being
 	// used), this defaults to a production zap logger.
@@ -107,6 +197,25 @@ func run() error {
 		return err
 	}
 
+	log.V(2).Info("Serve debug endpoints")
+	debugMux := http.NewServeMux()
+	debugMux.HandleFunc("/debug/finalization", projectclaim.FinalizationProgressHandler(mgr.GetClient()))
+	debugMux.HandleFunc("/ready", projectreference.PermissionSelfTestHandler())
+	if *debugReconciles {
+		debugMux.HandleFunc("/debug/reconciles", projectclaim.ReconcileDecisionsHandler())
+	}
+	go func() {
+		if err := http.ListenAndServe(debugAddr, debugMux); err != nil {
+			log.V(2).Info(err.Error())
+		}
+	}()
+
+	log.V(2).Info("Start the retained credentials sweep")
+	go projectreference.StartCredentialsSweep(mgr.GetClient(), stopCh)
+
+	log.V(2).Info("Run the GCP permission self-test")
+	go projectreference.RunPermissionSelfTest(mgr.GetClient())
+
 	log.V(2).Info("Expose metrics")
 	_, err = metrics.ExposeMetricsPort(ctx, metricsPort)
 	if err != nil {
@@ -17,6 +17,19 @@ type NamespacedName struct {
 	Namespace string `json:"namespace"`
 }
 
+// NetworkSpec configures the project network managed by the operator
+type NetworkSpec struct {
+	// Name is the network the baseline firewall rules are created on;
+	// defaults to the auto-created "default" network
+	// +optional
+	Name string `json:"name,omitempty"`
+	// BaselineFirewallRules requests creation of the standard baseline
+	// firewall rule set (allow internal traffic, allow SSH through IAP)
+	// on the network
+	// +optional
+	BaselineFirewallRules bool `json:"baselineFirewallRules,omitempty"`
+}
+
 // Condition contains details for the current condition of a custom resource
 type Condition struct {
 	// Type is the type of the condition.
@@ -35,6 +48,117 @@ type Condition struct {
 	// Message is a human-readable message indicating details about last transition.
 	// +optional
 	Message string `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the object the condition reflects,
+	// letting consumers ignore conditions from stale generations.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// Contact defines an essential contact to be attached to a project
+type Contact struct {
+	// Email is the address to notify.
+	Email string `json:"email"`
+	// Categories are the notification categories the contact subscribes to.
+	// +optional
+	Categories []string `json:"categories,omitempty"`
+}
+
+// NotificationChannel describes a monitoring notification channel to create in
+// the project, used to route alerts per team
+type NotificationChannel struct {
+	// Type is the channel type, e.g. "email" or "slack"
+	Type string `json:"type"`
+	// DisplayName is the name shown in the monitoring console
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+	// Labels configure the channel, e.g. "email_address" for email channels
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ParentType is a valid value for ProjectParent.Type
+type ParentType string
+
+const (
+	// ParentTypeFolder parents the project under a GCP folder
+	ParentTypeFolder ParentType = "folder"
+	// ParentTypeOrganization parents the project directly under a GCP organization
+	ParentTypeOrganization ParentType = "organization"
+)
+
+// ProjectParent identifies the folder or organization a project is created under
+type ProjectParent struct {
+	// Type is the kind of resource hierarchy node, either folder or organization.
+	Type ParentType `json:"type"`
+	// ID is the numeric ID of the folder or organization.
+	ID string `json:"id"`
+}
+
+// WorkloadIdentityProvider defines the OIDC provider created inside a workload identity pool
+type WorkloadIdentityProvider struct {
+	// ID is the workload identity pool provider ID.
+	ID string `json:"id"`
+	// IssuerURI is the OIDC issuer the provider trusts.
+	IssuerURI string `json:"issuerURI"`
+	// AllowedAudiences restricts which audiences the provider accepts.
+	// +optional
+	AllowedAudiences []string `json:"allowedAudiences,omitempty"`
+}
+
+// WorkloadIdentity defines a Workload Identity Federation pool and provider
+// to be provisioned in the project for keyless access
+type WorkloadIdentity struct {
+	// PoolID is the workload identity pool ID.
+	PoolID string `json:"poolID"`
+	// Provider is the OIDC provider to create inside the pool.
+	Provider WorkloadIdentityProvider `json:"provider"`
+}
+
+// KMSKeyRingSpec defines a KMS keyring with its keys to be provisioned in the
+// project for application encryption
+type KMSKeyRingSpec struct {
+	// Name is the keyring name.
+	Name string `json:"name"`
+	// Location is the GCP location the keyring is created in, e.g. "global" or a region.
+	Location string `json:"location"`
+	// KeyNames lists the symmetric encryption keys to create in the keyring.
+	KeyNames []string `json:"keyNames"`
+	// RotationPeriod enables automatic rotation of the keys with the given period.
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+}
+
+// RoleBinding grants a project-level IAM role to a set of members
+type RoleBinding struct {
+	// Role is the IAM role to grant, e.g. "roles/viewer".
+	Role string `json:"role"`
+	// Members are the IAM members granted the role, e.g. "user:jane@example.com".
+	Members []string `json:"members"`
+}
+
+// IAMPolicyMode controls how the role bindings requested in a spec are
+// reconciled against the project's IAM policy
+type IAMPolicyMode string
+
+const (
+	// IAMPolicyModeAdditive only ensures the listed members hold the listed
+	// roles, leaving members granted out-of-band in place
+	IAMPolicyModeAdditive IAMPolicyMode = "additive"
+	// IAMPolicyModeAuthoritative additionally removes members not listed in
+	// the spec from the listed roles
+	IAMPolicyModeAuthoritative IAMPolicyMode = "authoritative"
+)
+
+// ReconcileError records a single failed reconcile attempt
+type ReconcileError struct {
+	// Time is when the failure was observed.
+	Time metav1.Time `json:"time"`
+	// Reason is a unique, one-word, CamelCase reason for the failure.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable message indicating details about the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ConditionType is a valid value for Condition.Type
@@ -51,4 +175,66 @@ const (
 	ConditionError ConditionType = "Error"
 	// ConditionComputeApiReady is set when the compute API is not yet ready
 	ConditionComputeApiReady ConditionType = "ComputeApiReady"
+	// ConditionBillingReady is set when the billing account is linked to the project
+	ConditionBillingReady ConditionType = "BillingReady"
+	// ConditionContactsReady is set when the requested essential contacts are configured on the project
+	ConditionContactsReady ConditionType = "ContactsReady"
+	// ConditionCredentialsReady is set when the credentials secret is written with valid key material
+	ConditionCredentialsReady ConditionType = "CredentialsReady"
+	// ConditionProjectMoving is set while the project is being moved under a new parent
+	ConditionProjectMoving ConditionType = "ProjectMoving"
+	// ConditionCredentialSecretRecreated is set when a deleted credential secret was restored
+	ConditionCredentialSecretRecreated ConditionType = "CredentialSecretRecreated"
+	// ConditionCredentialSecretRemoved is set when credential secrets were
+	// deleted because the claim no longer wants a credential secret
+	ConditionCredentialSecretRemoved ConditionType = "CredentialSecretRemoved"
+	// ConditionPerimeterJoined is set when the project joins the requested VPC Service Controls perimeter
+	ConditionPerimeterJoined ConditionType = "PerimeterJoined"
+	// ConditionReconcileHold is set while the hold annotation keeps a claim at PendingProject
+	ConditionReconcileHold ConditionType = "ReconcileHold"
+	// ConditionBillingRelinked is set when the claim's billing account was re-linked
+	// because the billing account of its legal entity was remapped centrally
+	ConditionBillingRelinked ConditionType = "BillingRelinked"
+	// ConditionProjectNotEmpty is set when safe mode blocks project deletion
+	// because the project still holds resources
+	ConditionProjectNotEmpty ConditionType = "ProjectNotEmpty"
+	// ConditionIAMDrift indicates that the project's actual IAM policy
+	// diverged from the bindings managed by the operator
+	ConditionIAMDrift ConditionType = "IAMDrift"
+	// ConditionWorkloadIdentityReady indicates that the workload identity
+	// pool was bound to the project service account, so keyless claims can
+	// rely on it instead of a credential secret
+	ConditionWorkloadIdentityReady ConditionType = "WorkloadIdentityReady"
+	// ConditionDefaultNetworkSkipped indicates that the
+	// compute.skipDefaultNetworkCreation org policy is enforced on the
+	// project and its auto-created default network was removed
+	ConditionDefaultNetworkSkipped ConditionType = "DefaultNetworkSkipped"
+	// ConditionTemplateFound reports whether the ProjectReference template
+	// referenced by the claim's templateRef exists
+	ConditionTemplateFound ConditionType = "TemplateFound"
+	// ConditionLinkInconsistent is set when the claim and its ProjectReference
+	// disagree about each other in a way the operator cannot repair safely
+	ConditionLinkInconsistent ConditionType = "LinkInconsistent"
+	// ConditionKMSKeyRingsRetained is set during finalization when the
+	// project's KMS keyrings are left behind, because keyrings cannot be deleted
+	ConditionKMSKeyRingsRetained ConditionType = "KMSKeyRingsRetained"
+	// ConditionInsufficientGCPPermissions is set when a reconcile failed with a
+	// GCP permission-denied error, naming the missing permission when known
+	ConditionInsufficientGCPPermissions ConditionType = "InsufficientGCPPermissions"
+	// ConditionDraining is set while a claim is draining: consumers should
+	// stop using the project and the operator freezes its configuration
+	ConditionDraining ConditionType = "Draining"
+	// ConditionBillingError reports a billing subsystem failure. It is raised
+	// and lowered independently of other subsystem error conditions so
+	// concurrent failures stay visible
+	ConditionBillingError ConditionType = "BillingError"
+	// ConditionWaitingForDependencies is set while a claim waits for the
+	// claims listed in spec.dependsOn to become Ready
+	ConditionWaitingForDependencies ConditionType = "WaitingForDependencies"
+	// ConditionNamespaceTerminating is set when the claim's namespace is
+	// terminating and the reconcile is skipped in favour of garbage collection
+	ConditionNamespaceTerminating ConditionType = "NamespaceTerminating"
+	// ConditionQuotaError reports that a GCP quota blocked provisioning. It is
+	// raised and lowered independently of other subsystem error conditions
+	ConditionQuotaError ConditionType = "QuotaError"
 )
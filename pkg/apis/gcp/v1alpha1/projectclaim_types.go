@@ -16,13 +16,174 @@ type ProjectClaimSpec struct {
 	CCS                    bool           `json:"ccs,omitempty"`
 	CCSSecretRef           NamespacedName `json:"ccsSecretRef,omitempty"`
 	CCSProjectID           string         `json:"ccsProjectID,omitempty"`
+	BillingAccountID       string         `json:"billingAccountID,omitempty"`
+	EssentialContacts      []Contact      `json:"essentialContacts,omitempty"`
+	// ResourceLocationRestriction lists the locations where resources may be
+	// created in the project, enforced via the gcp.resourceLocations org policy constraint
+	ResourceLocationRestriction []string `json:"resourceLocationRestriction,omitempty"`
+	// TrustedImageProjects restricts which projects VM images may come from,
+	// enforced via the compute.trustedImageProjects org policy constraint
+	TrustedImageProjects []string `json:"trustedImageProjects,omitempty"`
+	// NotificationChannels lists monitoring notification channels to create in
+	// the project so alerts can be routed per team
+	NotificationChannels []NotificationChannel `json:"notificationChannels,omitempty"`
+	// RequestedAPIs lists additional GCP APIs to enable in the project beyond
+	// the mandatory defaults. Entries are validated against the org-approved
+	// API catalog in the operator ConfigMap, when one is configured
+	RequestedAPIs []string `json:"requestedAPIs,omitempty"`
+	// RequireOSLogin enforces the compute.requireOsLogin org policy constraint
+	// on the project so VM access goes through OS Login
+	RequireOSLogin bool `json:"requireOSLogin,omitempty"`
+	// DisableSerialPortAccess enforces the compute.disableSerialPortAccess org
+	// policy constraint on the project so VM serial ports cannot be reached
+	DisableSerialPortAccess bool `json:"disableSerialPortAccess,omitempty"`
+	// SkipCredentialsSecret skips service account key creation and the credentials
+	// secret, for teams that use Workload Identity instead of long-lived keys
+	SkipCredentialsSecret bool `json:"skipCredentialsSecret,omitempty"`
+	// Priority orders claims during bulk re-enqueues: higher values are reconciled
+	// first. It affects ordering only, not fairness guarantees. Defaults to 0.
+	Priority int `json:"priority,omitempty"`
+	// WorkloadIdentity provisions a Workload Identity Federation pool and provider
+	// in the project and binds them to the operator-created service account
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+	// Parent places the project under a specific folder or organization
+	// instead of the operator-wide default parent folder
+	Parent *ProjectParent `json:"parent,omitempty"`
+	// DisplayName sets the GCP project display name shown in the console,
+	// defaulting to the claim name when unset
+	DisplayName string `json:"displayName,omitempty"`
+	// Labels are applied to the GCP project for cost attribution and inventory
+	Labels map[string]string `json:"labels,omitempty"`
+	// Tags binds resource manager tag values to the project, given as tag key
+	// short name to value short name. Unlike labels, tags drive policy and
+	// IAM conditions; they must resolve to existing tag definitions
+	Tags map[string]string `json:"tags,omitempty"`
+	// CredentialSource references a Secret holding the operator credentials used
+	// to provision this claim's project, overriding the operator-wide default
+	CredentialSource *NamespacedName `json:"credentialSource,omitempty"`
+	// CredentialsRetention keeps the credentials secret around for the given
+	// duration after the claim is deleted, so cleanup jobs can still use it.
+	// The secret is annotated with its deletion time and swept afterwards
+	CredentialsRetention *metav1.Duration `json:"credentialsRetention,omitempty"`
+	// TemplateRef references a ProjectReference acting as a shared provisioning
+	// template. Its requested APIs, org policies and labels are merged into the
+	// generated ProjectReference as defaults; claim-level fields take precedence
+	TemplateRef *NamespacedName `json:"templateRef,omitempty"`
+	// ServicePerimeterName is the full resource name of a VPC Service Controls
+	// perimeter (accessPolicies/<policy>/servicePerimeters/<name>) the project
+	// must join before the claim becomes Ready
+	ServicePerimeterName string `json:"servicePerimeterName,omitempty"`
+	// DeleteDefaultNetwork requests that the auto-mode default network GCP
+	// adds to new projects is deleted, including its firewall rules
+	DeleteDefaultNetwork bool `json:"deleteDefaultNetwork,omitempty"`
+	// SkipDefaultNetwork enforces the compute.skipDefaultNetworkCreation org
+	// policy on the project and removes the network that was already
+	// auto-created with it. An operator-wide default can enable this for all
+	// claims
+	SkipDefaultNetwork bool `json:"skipDefaultNetwork,omitempty"`
+	// Network configures the project network managed by the operator, such as
+	// the baseline firewall rule set
+	Network *NetworkSpec `json:"network,omitempty"`
+	// KMSKeyRings lists KMS keyrings with their keys to create in the project
+	// for application encryption. Keyrings cannot be deleted; on claim deletion
+	// they are left behind and noted via the KMSKeyRingsRetained condition
+	KMSKeyRings []KMSKeyRingSpec `json:"kmsKeyRings,omitempty"`
+	// Regions lists the regions of a multi-region workload. Region is treated
+	// as the first entry, so single-region claims keep working unchanged
+	Regions []string `json:"regions,omitempty"`
+	// DefaultComputeRegion sets the google-compute-default-region project
+	// metadata so gcloud and client libraries default to this region
+	DefaultComputeRegion string `json:"defaultComputeRegion,omitempty"`
+	// DefaultComputeZone sets the google-compute-default-zone project
+	// metadata. The zone must lie within DefaultComputeRegion
+	DefaultComputeZone string `json:"defaultComputeZone,omitempty"`
+	// RoleBindings grants project-level IAM roles to the listed members
+	RoleBindings []RoleBinding `json:"roleBindings,omitempty"`
+	// IAMPolicyMode controls how RoleBindings are reconciled: additive (the
+	// default) only grants the listed roles, authoritative also removes
+	// members not listed in the spec from the listed roles
+	IAMPolicyMode IAMPolicyMode `json:"iamPolicyMode,omitempty"`
+	// SkipBillingManagement leaves the project's billing untouched: neither the
+	// operator-wide billing account nor BillingAccountID is verified or linked.
+	// Intended for imported projects whose billing is already correct
+	SkipBillingManagement bool `json:"skipBillingManagement,omitempty"`
+	// Drain marks the claim as draining for orderly offboarding: the Draining
+	// condition tells consumers to stop using the project and the operator
+	// freezes the project configuration instead of reconciling drift. The
+	// project is kept until the claim itself is deleted
+	Drain bool `json:"drain,omitempty"`
+	// DependsOn lists other ProjectClaims that must be Ready before this claim
+	// is provisioned, for workloads that need a hub project before its spokes.
+	// Cycles between claims are rejected
+	DependsOn []NamespacedName `json:"dependsOn,omitempty"`
+}
+
+// EffectiveRegions returns every region the claim requests, treating the
+// single Region field as the first entry for backward compatibility.
+func (s *ProjectClaimSpec) EffectiveRegions() []string {
+	regions := []string{}
+	if s.Region != "" {
+		regions = append(regions, s.Region)
+	}
+	for _, region := range s.Regions {
+		if region != s.Region {
+			regions = append(regions, region)
+		}
+	}
+	return regions
 }
 
 // ProjectClaimStatus defines the observed state of ProjectClaim
 // +k8s:openapi-gen=true
 type ProjectClaimStatus struct {
-	Conditions []Condition `json:"conditions"`
-	State      ClaimStatus `json:"state"`
+	Conditions   []Condition      `json:"conditions"`
+	State        ClaimStatus      `json:"state"`
+	RecentErrors []ReconcileError `json:"recentErrors,omitempty"`
+	// Phase is a coarse, watch-friendly grouping of State for consumers that
+	// only need to distinguish provisioning, active and failed claims
+	Phase ClaimPhase `json:"phase,omitempty"`
+	// ReconcileCount increments on every status-changing reconcile, giving
+	// watchers a reliable edge to trigger on; no-op reconciles leave it unchanged
+	ReconcileCount int64 `json:"reconcileCount,omitempty"`
+	// Timeline records when key provisioning milestones first occurred
+	Timeline ProjectClaimTimeline `json:"timeline,omitempty"`
+	// LastReconcileTime is when the claim was last reconciled without an
+	// error. It is only updated on success, so monitoring can alert on
+	// claims whose timestamp goes stale
+	LastReconcileTime metav1.Time `json:"lastReconcileTime,omitempty"`
+	// ReconciledBy identifies the operator instance that last reconciled the
+	// claim successfully, for debugging split ownership in HA deployments
+	ReconciledBy string `json:"reconciledBy,omitempty"`
+	// GCPProjectNumber is the numeric number of the GCP project, mirrored from
+	// the ProjectReference once the project is created. Some integrations need
+	// it instead of the string project ID, e.g. for default service account
+	// emails
+	GCPProjectNumber int64 `json:"gcpProjectNumber,omitempty"`
+	// ServiceAccountEmail is the email of the service account created for the
+	// project, mirrored from the ProjectReference so consumers do not have to
+	// read it out of the credential secret
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+	// Recoverable reports that the deleted GCP project can still be restored.
+	// GCP keeps deleted projects in a pending-deletion state for roughly 30
+	// days before purging them
+	Recoverable bool `json:"recoverable,omitempty"`
+	// RecoveryDeadline is when the pending-deletion window of the deleted GCP
+	// project is expected to close
+	RecoveryDeadline metav1.Time `json:"recoveryDeadline,omitempty"`
+}
+
+// ProjectClaimTimeline records when key provisioning milestones first
+// occurred. Each timestamp is set once and never overwritten, so consumers
+// can compute provisioning durations such as time to ready.
+type ProjectClaimTimeline struct {
+	// Created is when the operator first initialized the claim
+	Created metav1.Time `json:"created,omitempty"`
+	// ReferenceCreated is when the matching ProjectReference was created
+	ReferenceCreated metav1.Time `json:"referenceCreated,omitempty"`
+	// ProjectCreated is when the project was created in GCP
+	ProjectCreated metav1.Time `json:"projectCreated,omitempty"`
+	// Ready is when the claim first reached the Ready state
+	Ready metav1.Time `json:"ready,omitempty"`
 }
 
 // ClaimStatus is a valid value from ProjectClaim.Status
@@ -41,6 +202,19 @@ const (
 	ClaimStatusVerification ClaimStatus = "Verification"
 )
 
+// ClaimPhase is a coarse view of ProjectClaim.Status.State for watchers
+// that only care about major transitions
+type ClaimPhase string
+
+const (
+	// ClaimPhaseProvisioning groups the states where the project is still being set up
+	ClaimPhaseProvisioning ClaimPhase = "Provisioning"
+	// ClaimPhaseActive means the claim is Ready and the project is usable
+	ClaimPhaseActive ClaimPhase = "Active"
+	// ClaimPhaseFailed means the claim is in the Error state
+	ClaimPhaseFailed ClaimPhase = "Failed"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProjectClaim is the Schema for the projectclaims API
@@ -48,6 +222,7 @@ const (
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="Status of the project claim"
 // +kubebuilder:printcolumn:name="GCPProjectID",type="string",JSONPath=".spec.gcpProjectID",description="ID of the GCP Project that has been created"
+// +kubebuilder:printcolumn:name="GCPProjectNumber",type="integer",JSONPath=".status.gcpProjectNumber",description="Number of the GCP Project that has been created"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Age since the project claim was created"
 type ProjectClaim struct {
 	metav1.TypeMeta   `json:",inline"`
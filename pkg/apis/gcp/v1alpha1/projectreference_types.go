@@ -12,13 +12,106 @@ type ProjectReferenceSpec struct {
 	LegalEntity        LegalEntity    `json:"legalEntity"`
 	CCS                bool           `json:"ccs,omitempty"`
 	CCSSecretRef       NamespacedName `json:"ccsSecretRef,omitempty"`
+	EssentialContacts  []Contact      `json:"essentialContacts,omitempty"`
+	// ResourceLocationRestriction lists the locations where resources may be
+	// created in the project, enforced via the gcp.resourceLocations org policy constraint
+	ResourceLocationRestriction []string `json:"resourceLocationRestriction,omitempty"`
+	// TrustedImageProjects restricts which projects VM images may come from,
+	// enforced via the compute.trustedImageProjects org policy constraint
+	TrustedImageProjects []string `json:"trustedImageProjects,omitempty"`
+	// NotificationChannels lists monitoring notification channels to create in
+	// the project so alerts can be routed per team
+	NotificationChannels []NotificationChannel `json:"notificationChannels,omitempty"`
+	// RequestedAPIs lists additional GCP APIs to enable in the project beyond
+	// the mandatory defaults
+	RequestedAPIs []string `json:"requestedAPIs,omitempty"`
+	// RequireOSLogin enforces the compute.requireOsLogin org policy constraint
+	// on the project so VM access goes through OS Login
+	RequireOSLogin bool `json:"requireOSLogin,omitempty"`
+	// DisableSerialPortAccess enforces the compute.disableSerialPortAccess org
+	// policy constraint on the project so VM serial ports cannot be reached
+	DisableSerialPortAccess bool `json:"disableSerialPortAccess,omitempty"`
+	// SkipCredentialsSecret skips service account key creation and the credentials
+	// secret, for teams that use Workload Identity instead of long-lived keys
+	SkipCredentialsSecret bool `json:"skipCredentialsSecret,omitempty"`
+	// WorkloadIdentity provisions a Workload Identity Federation pool and provider
+	// in the project and binds them to the operator-created service account
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+	// Parent places the project under a specific folder or organization
+	// instead of the operator-wide default parent folder
+	Parent *ProjectParent `json:"parent,omitempty"`
+	// DisplayName sets the GCP project display name shown in the console,
+	// defaulting to the claim name when unset
+	DisplayName string `json:"displayName,omitempty"`
+	// Labels are applied to the GCP project for cost attribution and inventory
+	Labels map[string]string `json:"labels,omitempty"`
+	// Tags binds resource manager tag values to the project, given as tag key
+	// short name to value short name. They must resolve to existing tag
+	// definitions
+	Tags map[string]string `json:"tags,omitempty"`
+	// CredentialSource references a Secret holding the operator credentials used
+	// to provision this project, overriding the operator-wide default
+	CredentialSource *NamespacedName `json:"credentialSource,omitempty"`
+	// ServicePerimeterName is the full resource name of a VPC Service Controls
+	// perimeter (accessPolicies/<policy>/servicePerimeters/<name>) the project
+	// must join before it is reported Ready
+	ServicePerimeterName string `json:"servicePerimeterName,omitempty"`
+	// DeleteDefaultNetwork requests that the auto-mode default network GCP
+	// adds to new projects is deleted, including its firewall rules
+	DeleteDefaultNetwork bool `json:"deleteDefaultNetwork,omitempty"`
+	// SkipDefaultNetwork enforces the compute.skipDefaultNetworkCreation org
+	// policy on the project and removes the network that was already
+	// auto-created with it
+	SkipDefaultNetwork bool `json:"skipDefaultNetwork,omitempty"`
+	// Network configures the project network managed by the operator, such as
+	// the baseline firewall rule set
+	Network *NetworkSpec `json:"network,omitempty"`
+	// DefaultComputeRegion sets the google-compute-default-region project
+	// metadata so gcloud and client libraries default to this region
+	DefaultComputeRegion string `json:"defaultComputeRegion,omitempty"`
+	// DefaultComputeZone sets the google-compute-default-zone project
+	// metadata. The zone must lie within DefaultComputeRegion
+	DefaultComputeZone string `json:"defaultComputeZone,omitempty"`
+	// RoleBindings grants project-level IAM roles to the listed members
+	RoleBindings []RoleBinding `json:"roleBindings,omitempty"`
+	// IAMPolicyMode controls how RoleBindings are reconciled: additive (the
+	// default) only grants the listed roles, authoritative also removes
+	// members not listed in the spec from the listed roles
+	IAMPolicyMode IAMPolicyMode `json:"iamPolicyMode,omitempty"`
+	// SkipBillingManagement leaves the project's billing untouched: no billing
+	// account is verified or linked
+	SkipBillingManagement bool `json:"skipBillingManagement,omitempty"`
+	// KMSKeyRings lists KMS keyrings with their keys to create in the project
+	// for application encryption
+	KMSKeyRings []KMSKeyRingSpec `json:"kmsKeyRings,omitempty"`
 }
 
 // ProjectReferenceStatus defines the observed state of Project
 // +k8s:openapi-gen=true
 type ProjectReferenceStatus struct {
-	Conditions []Condition           `json:"conditions"`
-	State      ProjectReferenceState `json:"state"`
+	Conditions   []Condition           `json:"conditions"`
+	State        ProjectReferenceState `json:"state"`
+	RecentErrors []ReconcileError      `json:"recentErrors,omitempty"`
+	// FinalizationRetries counts consecutive failed finalization attempts and
+	// drives the exponential backoff between deletion retries
+	FinalizationRetries int `json:"finalizationRetries,omitempty"`
+	// NotificationChannelIDs holds the resource names of the monitoring
+	// notification channels created for the project, for later alert wiring
+	NotificationChannelIDs []string `json:"notificationChannelIDs,omitempty"`
+	// GCPProjectNumber is the numeric number of the GCP project, recorded once
+	// the project is created and mirrored to the claim status
+	GCPProjectNumber int64 `json:"gcpProjectNumber,omitempty"`
+	// ServiceAccountEmail is the email of the service account created for the
+	// project, recorded once the account exists and mirrored to the claim status
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+	// LastUpdate is when the operator last wrote this status, used to measure
+	// the propagation lag until the matching claim mirrors it
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+	// PhaseStartTimes records when each in-flight provisioning phase began, so
+	// phase durations are measured across requeues instead of per reconcile
+	PhaseStartTimes map[string]metav1.Time `json:"phaseStartTimes,omitempty"`
+	// PhaseDurations records how long each completed provisioning phase took
+	PhaseDurations map[string]metav1.Duration `json:"phaseDurations,omitempty"`
 }
 
 // ProjectReferenceState is a valid value from ProjectReference.Status
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -26,6 +27,53 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Contact) DeepCopyInto(out *Contact) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Contact.
+func (in *Contact) DeepCopy() *Contact {
+	if in == nil {
+		return nil
+	}
+	out := new(Contact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSKeyRingSpec) DeepCopyInto(out *KMSKeyRingSpec) {
+	*out = *in
+	if in.KeyNames != nil {
+		in, out := &in.KeyNames, &out.KeyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeyRingSpec.
+func (in *KMSKeyRingSpec) DeepCopy() *KMSKeyRingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSKeyRingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LegalEntity) DeepCopyInto(out *LegalEntity) {
 	*out = *in
@@ -58,6 +106,22 @@ func (in *NamespacedName) DeepCopy() *NamespacedName {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectClaim) DeepCopyInto(out *ProjectClaim) {
 	*out = *in
@@ -131,6 +195,103 @@ func (in *ProjectClaimSpec) DeepCopyInto(out *ProjectClaimSpec) {
 		copy(*out, *in)
 	}
 	out.CCSSecretRef = in.CCSSecretRef
+	if in.EssentialContacts != nil {
+		in, out := &in.EssentialContacts, &out.EssentialContacts
+		*out = make([]Contact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceLocationRestriction != nil {
+		in, out := &in.ResourceLocationRestriction, &out.ResourceLocationRestriction
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedImageProjects != nil {
+		in, out := &in.TrustedImageProjects, &out.TrustedImageProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationChannels != nil {
+		in, out := &in.NotificationChannels, &out.NotificationChannels
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequestedAPIs != nil {
+		in, out := &in.RequestedAPIs, &out.RequestedAPIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(ProjectParent)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CredentialSource != nil {
+		in, out := &in.CredentialSource, &out.CredentialSource
+		*out = new(NamespacedName)
+		**out = **in
+	}
+	if in.CredentialsRetention != nil {
+		in, out := &in.CredentialsRetention, &out.CredentialsRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(NamespacedName)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkSpec)
+		**out = **in
+	}
+	if in.KMSKeyRings != nil {
+		in, out := &in.KMSKeyRings, &out.KMSKeyRings
+		*out = make([]KMSKeyRingSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]RoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -154,6 +315,16 @@ func (in *ProjectClaimStatus) DeepCopyInto(out *ProjectClaimStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RecentErrors != nil {
+		in, out := &in.RecentErrors, &out.RecentErrors
+		*out = make([]ReconcileError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Timeline.DeepCopyInto(&out.Timeline)
+	in.LastReconcileTime.DeepCopyInto(&out.LastReconcileTime)
+	in.RecoveryDeadline.DeepCopyInto(&out.RecoveryDeadline)
 	return
 }
 
@@ -167,12 +338,32 @@ func (in *ProjectClaimStatus) DeepCopy() *ProjectClaimStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectClaimTimeline) DeepCopyInto(out *ProjectClaimTimeline) {
+	*out = *in
+	in.Created.DeepCopyInto(&out.Created)
+	in.ReferenceCreated.DeepCopyInto(&out.ReferenceCreated)
+	in.ProjectCreated.DeepCopyInto(&out.ProjectCreated)
+	in.Ready.DeepCopyInto(&out.Ready)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectClaimTimeline.
+func (in *ProjectClaimTimeline) DeepCopy() *ProjectClaimTimeline {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectClaimTimeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectReference) DeepCopyInto(out *ProjectReference) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -234,6 +425,83 @@ func (in *ProjectReferenceSpec) DeepCopyInto(out *ProjectReferenceSpec) {
 	out.ProjectClaimCRLink = in.ProjectClaimCRLink
 	out.LegalEntity = in.LegalEntity
 	out.CCSSecretRef = in.CCSSecretRef
+	if in.EssentialContacts != nil {
+		in, out := &in.EssentialContacts, &out.EssentialContacts
+		*out = make([]Contact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceLocationRestriction != nil {
+		in, out := &in.ResourceLocationRestriction, &out.ResourceLocationRestriction
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedImageProjects != nil {
+		in, out := &in.TrustedImageProjects, &out.TrustedImageProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationChannels != nil {
+		in, out := &in.NotificationChannels, &out.NotificationChannels
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequestedAPIs != nil {
+		in, out := &in.RequestedAPIs, &out.RequestedAPIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(WorkloadIdentity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parent != nil {
+		in, out := &in.Parent, &out.Parent
+		*out = new(ProjectParent)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CredentialSource != nil {
+		in, out := &in.CredentialSource, &out.CredentialSource
+		*out = new(NamespacedName)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkSpec)
+		**out = **in
+	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]RoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KMSKeyRings != nil {
+		in, out := &in.KMSKeyRings, &out.KMSKeyRings
+		*out = make([]KMSKeyRingSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -257,6 +525,33 @@ func (in *ProjectReferenceStatus) DeepCopyInto(out *ProjectReferenceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RecentErrors != nil {
+		in, out := &in.RecentErrors, &out.RecentErrors
+		*out = make([]ReconcileError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotificationChannelIDs != nil {
+		in, out := &in.NotificationChannelIDs, &out.NotificationChannelIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdate.DeepCopyInto(&out.LastUpdate)
+	if in.PhaseStartTimes != nil {
+		in, out := &in.PhaseStartTimes, &out.PhaseStartTimes
+		*out = make(map[string]v1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.PhaseDurations != nil {
+		in, out := &in.PhaseDurations, &out.PhaseDurations
+		*out = make(map[string]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -269,3 +564,118 @@ func (in *ProjectReferenceStatus) DeepCopy() *ProjectReferenceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleBinding) DeepCopyInto(out *RoleBinding) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBinding.
+func (in *RoleBinding) DeepCopy() *RoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileError) DeepCopyInto(out *ReconcileError) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileError.
+func (in *ReconcileError) DeepCopy() *ReconcileError {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentity) DeepCopyInto(out *WorkloadIdentity) {
+	*out = *in
+	in.Provider.DeepCopyInto(&out.Provider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentity.
+func (in *WorkloadIdentity) DeepCopy() *WorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityProvider) DeepCopyInto(out *WorkloadIdentityProvider) {
+	*out = *in
+	if in.AllowedAudiences != nil {
+		in, out := &in.AllowedAudiences, &out.AllowedAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityProvider.
+func (in *WorkloadIdentityProvider) DeepCopy() *WorkloadIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectParent) DeepCopyInto(out *ProjectParent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectParent.
+func (in *ProjectParent) DeepCopy() *ProjectParent {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
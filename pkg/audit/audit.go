@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var log = logf.Log.WithName("audit")
+
+// Actor identifies the operator in audit records; provisioning actions are
+// always performed by the operator's own service account, not by the user
+// who created the claim.
+const Actor = "gcp-project-operator"
+
+// Record is a single append-only audit trail entry describing a provisioning
+// action the operator performed on behalf of a claim.
+type Record struct {
+	// Action is a unique, one-word, CamelCase name of the performed action.
+	Action string `json:"action"`
+	// Claim is the namespace/name of the ProjectClaim the action was performed for.
+	Claim string `json:"claim"`
+	// ProjectID is the GCP project the action targeted, if known yet.
+	ProjectID string `json:"projectID,omitempty"`
+	// Actor is the identity that performed the action.
+	Actor string `json:"actor"`
+	// Timestamp is when the action was performed, in UTC.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AuditSink receives audit records emitted at key reconcile points. Submissions
+// must not block reconciliation; implementations that buffer or batch are
+// responsible for their own flushing.
+type AuditSink interface {
+	Submit(record Record) error
+}
+
+// NoopSink discards all records. It is the default so clusters without an
+// external audit requirement pay no cost.
+type NoopSink struct{}
+
+// Submit discards the record.
+func (NoopSink) Submit(record Record) error {
+	return nil
+}
+
+var sink AuditSink = NoopSink{}
+
+// SetSink replaces the audit sink records are published to. Passing nil
+// restores the default no-op sink.
+func SetSink(s AuditSink) {
+	if s == nil {
+		sink = NoopSink{}
+		return
+	}
+	sink = s
+}
+
+// Publish emits an audit record to the configured sink, stamping the actor and
+// timestamp. Sink failures are logged but never fail the reconcile: the audit
+// trail is best-effort from the operator's point of view, losing a record must
+// not block provisioning.
+func Publish(action, claim, projectID string) {
+	record := Record{
+		Action:    action,
+		Claim:     claim,
+		ProjectID: projectID,
+		Actor:     Actor,
+		Timestamp: time.Now().UTC(),
+	}
+	if err := sink.Submit(record); err != nil {
+		log.Error(err, fmt.Sprintf("could not submit audit record for action %s", action))
+	}
+}
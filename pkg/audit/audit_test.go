@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingSink captures every submitted record for inspection.
+type recordingSink struct {
+	records []Record
+	err     error
+}
+
+func (r *recordingSink) Submit(record Record) error {
+	r.records = append(r.records, record)
+	return r.err
+}
+
+func TestPublishStampsActorAndTimestamp(t *testing.T) {
+	sink := &recordingSink{}
+	SetSink(sink)
+	defer SetSink(nil)
+
+	before := time.Now().UTC()
+	Publish("CreateProject", "fake-namespace/fake-claim", "fake-gcp-project")
+
+	assert.Len(t, sink.records, 1)
+	record := sink.records[0]
+	assert.Equal(t, "CreateProject", record.Action)
+	assert.Equal(t, "fake-namespace/fake-claim", record.Claim)
+	assert.Equal(t, "fake-gcp-project", record.ProjectID)
+	assert.Equal(t, Actor, record.Actor)
+	assert.False(t, record.Timestamp.Before(before))
+}
+
+func TestPublishSwallowsSinkErrors(t *testing.T) {
+	sink := &recordingSink{err: errors.New("sink unavailable")}
+	SetSink(sink)
+	defer SetSink(nil)
+
+	assert.NotPanics(t, func() {
+		Publish("DeleteProject", "fake-namespace/fake-claim", "fake-gcp-project")
+	})
+	assert.Len(t, sink.records, 1)
+}
+
+func TestSetSinkNilRestoresNoop(t *testing.T) {
+	SetSink(&recordingSink{})
+	SetSink(nil)
+
+	assert.NotPanics(t, func() {
+		Publish("LinkBillingAccount", "fake-namespace/fake-claim", "fake-gcp-project")
+	})
+}
@@ -1,11 +1,52 @@
 package condition
 
 import (
+	"fmt"
+	"time"
+
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var log = logf.Log.WithName("condition")
+
+const (
+	// RecentErrorsMaxEntries is the default number of entries kept in Status.RecentErrors
+	RecentErrorsMaxEntries = 10
+	// recentErrorMessageMaxLength bounds the message size of a single entry so the object doesn't bloat
+	recentErrorMessageMaxLength = 256
+	// DefaultConditionRetention is how long a False condition is kept before it is pruned
+	DefaultConditionRetention = 24 * time.Hour
+	// defaultMessageMaxLength bounds condition messages so huge GCP error
+	// responses do not bloat etcd
+	defaultMessageMaxLength = 1024
 )
 
+// messageMaxLength is the active cap on condition message length, set from
+// the command line at startup. Defaults to defaultMessageMaxLength.
+var messageMaxLength = defaultMessageMaxLength
+
+// SetMessageMaxLength sets how long condition messages may grow before
+// TruncateMessage shortens them. Values of zero or below restore the default.
+func SetMessageMaxLength(length int) {
+	if length <= 0 {
+		messageMaxLength = defaultMessageMaxLength
+		return
+	}
+	messageMaxLength = length
+}
+
+// TruncateMessage shortens a condition message to the configured maximum
+// length, keeping the prefix and marking the cut with an ellipsis.
+func TruncateMessage(message string) string {
+	if len(message) <= messageMaxLength {
+		return message
+	}
+	return message[:messageMaxLength] + "..."
+}
+
 // Conditions is a wrapper object for actual Condition functions to allow for easier mocking/testing.
 //go:generate mockgen -destination=../util/mocks/$GOPACKAGE/conditions.go -package=$GOPACKAGE -source conditions.go
 type Conditions interface {
@@ -25,6 +66,17 @@ func NewConditionManager() Conditions {
 func (c *ConditionManager) SetCondition(conditions *[]gcpv1alpha1.Condition, conditionType gcpv1alpha1.ConditionType, status corev1.ConditionStatus, reason string, message string) {
 	now := metav1.Now()
 	condition, _ := c.FindCondition(conditions, conditionType)
+	// Clock skew across controller restarts can leave the existing timestamps
+	// in the future; never write an earlier timestamp so they stay monotonic.
+	if now.Before(&condition.LastProbeTime) || now.Before(&condition.LastTransitionTime) {
+		log.Info(fmt.Sprintf("Warning: clock skew detected, condition %s carries a timestamp from the future; clamping to keep timestamps monotonic", conditionType))
+		if now.Before(&condition.LastProbeTime) {
+			now = condition.LastProbeTime
+		}
+		if now.Before(&condition.LastTransitionTime) {
+			now = condition.LastTransitionTime
+		}
+	}
 	if message != condition.Message ||
 		status != condition.Status ||
 		reason != condition.Reason ||
@@ -40,6 +92,45 @@ func (c *ConditionManager) SetCondition(conditions *[]gcpv1alpha1.Condition, con
 	condition.Status = status
 }
 
+// PruneConditions removes conditions that have been False and untouched for longer
+// than the given retention, keeping the status concise on long-lived objects.
+func PruneConditions(conditions []gcpv1alpha1.Condition, retention time.Duration) []gcpv1alpha1.Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	cutoff := metav1.NewTime(time.Now().Add(-retention))
+	pruned := make([]gcpv1alpha1.Condition, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition.Status == corev1.ConditionFalse && condition.LastProbeTime.Before(&cutoff) {
+			continue
+		}
+		pruned = append(pruned, condition)
+	}
+
+	return pruned
+}
+
+// AppendRecentError appends a failed reconcile to a bounded error history,
+// dropping the oldest entries once maxEntries is exceeded.
+func AppendRecentError(recentErrors []gcpv1alpha1.ReconcileError, reason string, err error, maxEntries int) []gcpv1alpha1.ReconcileError {
+	message := err.Error()
+	if len(message) > recentErrorMessageMaxLength {
+		message = message[:recentErrorMessageMaxLength] + "..."
+	}
+
+	recentErrors = append(recentErrors, gcpv1alpha1.ReconcileError{
+		Time:    metav1.Now(),
+		Reason:  reason,
+		Message: message,
+	})
+	if len(recentErrors) > maxEntries {
+		recentErrors = recentErrors[len(recentErrors)-maxEntries:]
+	}
+
+	return recentErrors
+}
+
 // FindCondition finds the suitable Condition object
 // by looking for adapter's condition list.
 // If none exists, it appends one.
@@ -1,6 +1,11 @@
 package condition
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
@@ -104,6 +109,127 @@ var _ = Describe("ConditionManager", func() {
 			Expect(obj.LastProbeTime).NotTo(Equal(old.LastProbeTime))
 		})
 	})
+
+	Context("when the existing condition carries a timestamp from the future", func() {
+		status := corev1.ConditionTrue
+		future := metav1.NewTime(metav1.Now().Add(10 * time.Minute))
+		BeforeEach(func() {
+			sut = []gcpv1alpha1.Condition{
+				{
+					Message:            "DummyError",
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: future,
+					LastProbeTime:      future,
+					Reason:             "DummyResolved",
+					Type:               gcpv1alpha1.ConditionError,
+				},
+			}
+		})
+		It("should never move the timestamps backwards", func() {
+			conditionManager.SetCondition(&sut, conditionType, status, reason, message)
+			obj := getFirst(sut)
+			Expect(obj.LastProbeTime.Before(&future)).To(BeFalse())
+			Expect(obj.LastTransitionTime.Before(&future)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("PruneConditions", func() {
+	var (
+		sut []gcpv1alpha1.Condition
+	)
+	old := metav1.NewTime(metav1.Now().Add(-2 * DefaultConditionRetention))
+	recent := metav1.Now()
+
+	BeforeEach(func() {
+		sut = []gcpv1alpha1.Condition{
+			{Type: gcpv1alpha1.ConditionError, Status: corev1.ConditionFalse, LastProbeTime: old},
+			{Type: gcpv1alpha1.ConditionPending, Status: corev1.ConditionFalse, LastProbeTime: recent},
+			{Type: gcpv1alpha1.ConditionReady, Status: corev1.ConditionTrue, LastProbeTime: old},
+		}
+	})
+
+	Context("when a False condition is older than the retention", func() {
+		It("removes it while retaining recent and True conditions", func() {
+			sut = PruneConditions(sut, DefaultConditionRetention)
+			Expect(len(sut)).To(Equal(2))
+			Expect(sut[0].Type).To(Equal(gcpv1alpha1.ConditionPending))
+			Expect(sut[1].Type).To(Equal(gcpv1alpha1.ConditionReady))
+		})
+	})
+
+	Context("when the condition list is nil", func() {
+		It("returns nil", func() {
+			Expect(PruneConditions(nil, DefaultConditionRetention)).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("AppendRecentError", func() {
+	var (
+		sut []gcpv1alpha1.ReconcileError
+	)
+	reason := "dummyReconcile"
+	err := errors.New("fake error")
+
+	BeforeEach(func() {
+		sut = []gcpv1alpha1.ReconcileError{}
+	})
+
+	Context("when called on an empty history", func() {
+		It("appends a single entry", func() {
+			sut = AppendRecentError(sut, reason, err, RecentErrorsMaxEntries)
+			Expect(len(sut)).To(Equal(1))
+			Expect(sut[0].Reason).To(Equal(reason))
+			Expect(sut[0].Message).To(Equal(err.Error()))
+			Expect(sut[0].Time.IsZero()).To(BeFalse())
+		})
+	})
+
+	Context("when called more often than the buffer size", func() {
+		It("truncates the oldest entries", func() {
+			for i := 0; i < RecentErrorsMaxEntries+3; i++ {
+				sut = AppendRecentError(sut, reason, fmt.Errorf("fake error %d", i), RecentErrorsMaxEntries)
+			}
+			Expect(len(sut)).To(Equal(RecentErrorsMaxEntries))
+			Expect(sut[0].Message).To(Equal("fake error 3"))
+			Expect(sut[len(sut)-1].Message).To(Equal(fmt.Sprintf("fake error %d", RecentErrorsMaxEntries+2)))
+		})
+	})
+
+	Context("when the error message is too long", func() {
+		It("truncates the message with an ellipsis", func() {
+			sut = AppendRecentError(sut, reason, errors.New(strings.Repeat("x", 1000)), RecentErrorsMaxEntries)
+			Expect(len(sut[0].Message)).To(BeNumerically("<", 1000))
+			Expect(sut[0].Message).To(HaveSuffix("..."))
+		})
+	})
+})
+
+var _ = Describe("TruncateMessage", func() {
+	Context("when the message fits the configured length", func() {
+		It("returns it unchanged", func() {
+			Expect(TruncateMessage("short message")).To(Equal("short message"))
+		})
+	})
+
+	Context("when the message exceeds the configured length", func() {
+		It("keeps the prefix and appends an ellipsis", func() {
+			truncated := TruncateMessage(strings.Repeat("x", 2000))
+			Expect(truncated).To(Equal(strings.Repeat("x", 1024) + "..."))
+		})
+	})
+
+	Context("when a custom length is configured", func() {
+		AfterEach(func() {
+			SetMessageMaxLength(0)
+		})
+
+		It("truncates to the configured length", func() {
+			SetMessageMaxLength(10)
+			Expect(TruncateMessage("a much too long message")).To(Equal("a much too..."))
+		})
+	})
 })
 
 func getFirst(list []gcpv1alpha1.Condition) gcpv1alpha1.Condition {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/mitchellh/mapstructure"
 	corev1 "k8s.io/api/core/v1"
@@ -14,7 +15,19 @@ import (
 // OperatorConfigMapName holds the name of configmap
 const (
 	OperatorConfigMapName      = "gcp-project-operator"
-	operatorConfigMapNamespace = "gcp-project-operator"
+	OperatorConfigMapNamespace = "gcp-project-operator"
+
+	// ReconcileEnabledKey is the operator ConfigMap key acting as a reconcile kill-switch
+	ReconcileEnabledKey = "reconcile.enabled"
+
+	// ApprovedAPIsKey is the operator ConfigMap key holding the comma-separated
+	// org-approved API catalog. Claims may only request APIs from this catalog.
+	ApprovedAPIsKey = "approved.apis"
+
+	// LegalEntityBillingKeyPrefix is the operator ConfigMap key prefix of the central
+	// LegalEntity-to-billing-account mapping. The billing account for a legal entity
+	// is stored under "billing.legalEntity.<legalEntityID>".
+	LegalEntityBillingKeyPrefix = "billing.legalEntity."
 )
 
 // OperatorConfigMap store data for the specified configmap
@@ -38,11 +51,62 @@ func ValidateOperatorConfigMap(configmap OperatorConfigMap) error {
 	return nil
 }
 
+// ReconcileEnabled reports whether the reconcile kill-switch allows reconciliation.
+// Only an explicit "false" pauses the operator; a missing ConfigMap or key keeps it running.
+func ReconcileEnabled(kubeClient client.Client) bool {
+	configmap := &corev1.ConfigMap{}
+	if err := kubeClient.Get(context.TODO(), kubetypes.NamespacedName{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace}, configmap); err != nil {
+		return true
+	}
+
+	return configmap.Data[ReconcileEnabledKey] != "false"
+}
+
+// ApprovedAPIs returns the org-approved API catalog and whether one is
+// configured. A missing ConfigMap or key means no catalog is enforced and
+// any requested API is allowed.
+func ApprovedAPIs(kubeClient client.Client) ([]string, bool) {
+	configmap := &corev1.ConfigMap{}
+	if err := kubeClient.Get(context.TODO(), kubetypes.NamespacedName{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace}, configmap); err != nil {
+		return nil, false
+	}
+
+	catalog, ok := configmap.Data[ApprovedAPIsKey]
+	if !ok || strings.TrimSpace(catalog) == "" {
+		return nil, false
+	}
+
+	apis := []string{}
+	for _, api := range strings.Split(catalog, ",") {
+		if api := strings.TrimSpace(api); api != "" {
+			apis = append(apis, api)
+		}
+	}
+	return apis, true
+}
+
+// LegalEntityBillingAccount returns the centrally configured billing account for
+// the given legal entity and whether one is configured. A missing ConfigMap or
+// key means the legal entity has no central mapping and the billing account
+// requested on the claim stays authoritative.
+func LegalEntityBillingAccount(kubeClient client.Client, legalEntityID string) (string, bool) {
+	configmap := &corev1.ConfigMap{}
+	if err := kubeClient.Get(context.TODO(), kubetypes.NamespacedName{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace}, configmap); err != nil {
+		return "", false
+	}
+
+	account, ok := configmap.Data[LegalEntityBillingKeyPrefix+legalEntityID]
+	if !ok || strings.TrimSpace(account) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(account), true
+}
+
 // GetOperatorConfigMap returns a configmap defined in requested namespace and name
 func GetOperatorConfigMap(kubeClient client.Client) (OperatorConfigMap, error) {
 	var OperatorConfigMap OperatorConfigMap
 	configmap := &corev1.ConfigMap{}
-	if err := kubeClient.Get(context.TODO(), kubetypes.NamespacedName{Name: OperatorConfigMapName, Namespace: operatorConfigMapNamespace}, configmap); err != nil {
+	if err := kubeClient.Get(context.TODO(), kubetypes.NamespacedName{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace}, configmap); err != nil {
 		return OperatorConfigMap, fmt.Errorf("unable to get configmap: %v", err)
 	}
 
@@ -28,6 +28,59 @@ func TestValidateOperatorConfigMap(t *testing.T) {
 
 }
 
+func TestReconcileEnabled(t *testing.T) {
+	tests := []struct {
+		name           string
+		localObjects   []runtime.Object
+		expectedResult bool
+	}{
+		{
+			name:           "ConfigMap does not exist",
+			localObjects:   []runtime.Object{},
+			expectedResult: true,
+		},
+		{
+			name: "Key not set",
+			localObjects: []runtime.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace},
+					Data:       map[string]string{},
+				},
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Reconcile enabled",
+			localObjects: []runtime.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace},
+					Data:       map[string]string{ReconcileEnabledKey: "true"},
+				},
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Reconcile paused",
+			localObjects: []runtime.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigMapName, Namespace: OperatorConfigMapNamespace},
+					Data:       map[string]string{ReconcileEnabledKey: "false"},
+				},
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mocks := builders.SetupDefaultMocks(t, test.localObjects)
+
+			result := ReconcileEnabled(mocks.FakeKubeClient)
+			assert.Equal(t, test.expectedResult, result)
+		})
+	}
+}
+
 func TestGetOperatorConfigMap(t *testing.T) {
 	tests := []struct {
 		name                   string
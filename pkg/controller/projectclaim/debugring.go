@@ -0,0 +1,73 @@
+package projectclaim
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// reconcileDebugEnabled turns on recording of reconcile decisions in the ring
+// buffer below. Off by default since the records hold per-operation detail.
+var reconcileDebugEnabled bool
+
+// SetReconcileDebug toggles recording of reconcile decisions for the
+// /debug/reconciles endpoint. Configured via --debug-reconciles.
+func SetReconcileDebug(enabled bool) {
+	reconcileDebugEnabled = enabled
+}
+
+// reconcileDecisionCapacity caps how many reconcile decisions are kept; older
+// records are dropped as new ones come in.
+const reconcileDecisionCapacity = 128
+
+// OperationDecision records the outcome of a single Ensure operation within a
+// reconcile pass.
+type OperationDecision struct {
+	Operation string `json:"operation"`
+	Requeue   bool   `json:"requeue,omitempty"`
+	Cancel    bool   `json:"cancel,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ReconcileDecision records one reconcile pass of a claim: when it ran, which
+// claim it was for and what each operation decided. Operations after the first
+// requeue or cancel did not run and are absent.
+type ReconcileDecision struct {
+	Time       time.Time           `json:"time"`
+	Claim      string              `json:"claim"`
+	Operations []OperationDecision `json:"operations"`
+}
+
+var reconcileDecisionsMutex sync.Mutex
+var reconcileDecisions []ReconcileDecision
+
+// recordReconcileDecision appends a decision to the ring buffer, dropping the
+// oldest record when the buffer is full.
+func recordReconcileDecision(decision ReconcileDecision) {
+	reconcileDecisionsMutex.Lock()
+	defer reconcileDecisionsMutex.Unlock()
+	reconcileDecisions = append(reconcileDecisions, decision)
+	if len(reconcileDecisions) > reconcileDecisionCapacity {
+		reconcileDecisions = reconcileDecisions[len(reconcileDecisions)-reconcileDecisionCapacity:]
+	}
+}
+
+// RecentReconcileDecisions returns the recorded reconcile decisions, oldest
+// first.
+func RecentReconcileDecisions() []ReconcileDecision {
+	reconcileDecisionsMutex.Lock()
+	defer reconcileDecisionsMutex.Unlock()
+	decisions := make([]ReconcileDecision, len(reconcileDecisions))
+	copy(decisions, reconcileDecisions)
+	return decisions
+}
+
+// ReconcileDecisionsHandler serves the recent reconcile decisions as JSON on
+// the operator's debug endpoint.
+func ReconcileDecisionsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(RecentReconcileDecisions())
+	}
+}
@@ -0,0 +1,63 @@
+package projectclaim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// finalizationStuckThreshold is how long a claim may be terminating before
+// FinalizationProgress counts it as stuck.
+const finalizationStuckThreshold = 15 * time.Minute
+
+// FinalizationProgress reports teardown progress across all ProjectClaims:
+// the total number of claims, how many are terminating and how many have been
+// terminating for longer than the stuck threshold. During a cluster
+// decommission ops use it to confirm a clean teardown. The counts are also
+// published as metrics.
+func FinalizationProgress(ctx context.Context, kubeClient client.Client) (total, terminating, stuck int, err error) {
+	claimList := &gcpv1alpha1.ProjectClaimList{}
+	if err := kubeClient.List(ctx, &client.ListOptions{}, claimList); err != nil {
+		return 0, 0, 0, err
+	}
+
+	now := time.Now()
+	for _, claim := range claimList.Items {
+		total++
+		if claim.DeletionTimestamp == nil {
+			continue
+		}
+		terminating++
+		if now.Sub(claim.DeletionTimestamp.Time) > finalizationStuckThreshold {
+			stuck++
+		}
+	}
+
+	localmetrics.ClaimsTerminating.Set(float64(terminating))
+	localmetrics.ClaimsFinalizationStuck.Set(float64(stuck))
+
+	return total, terminating, stuck, nil
+}
+
+// FinalizationProgressHandler serves the finalization progress as JSON on the
+// operator's debug endpoint.
+func FinalizationProgressHandler(kubeClient client.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		total, terminating, stuck, err := FinalizationProgress(r.Context(), kubeClient)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]int{
+			"total":       total,
+			"terminating": terminating,
+			"stuck":       stuck,
+		})
+	}
+}
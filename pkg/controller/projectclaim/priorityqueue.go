@@ -0,0 +1,70 @@
+package projectclaim
+
+import (
+	"container/heap"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// PriorityQueue orders reconcile requests by descending claim priority.
+// Requests with equal priority keep their insertion order. It only affects
+// the order in which requests are enqueued, not fairness between claims.
+type PriorityQueue struct {
+	items requestHeap
+	seq   int
+}
+
+type priorityItem struct {
+	request  reconcile.Request
+	priority int
+	seq      int
+}
+
+type requestHeap []priorityItem
+
+func (h requestHeap) Len() int { return len(h) }
+
+func (h requestHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h requestHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *requestHeap) Push(x interface{}) {
+	*h = append(*h, x.(priorityItem))
+}
+
+func (h *requestHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// NewPriorityQueue returns an empty PriorityQueue
+func NewPriorityQueue() *PriorityQueue {
+	return &PriorityQueue{}
+}
+
+// Add inserts a request with the given priority
+func (q *PriorityQueue) Add(request reconcile.Request, priority int) {
+	heap.Push(&q.items, priorityItem{request: request, priority: priority, seq: q.seq})
+	q.seq++
+}
+
+// Pop removes and returns the request with the highest priority.
+// The second return value is false if the queue is empty.
+func (q *PriorityQueue) Pop() (reconcile.Request, bool) {
+	if q.items.Len() == 0 {
+		return reconcile.Request{}, false
+	}
+	return heap.Pop(&q.items).(priorityItem).request, true
+}
+
+// Len returns the number of queued requests
+func (q *PriorityQueue) Len() int {
+	return q.items.Len()
+}
@@ -2,18 +2,31 @@ package projectclaim
 
 import (
 	"context"
+	goerrors "errors"
+	"reflect"
+	goruntime "runtime"
 	"time"
 
+	clusterapi "github.com/openshift/cluster-api/pkg/util"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	condition "github.com/openshift/gcp-project-operator/pkg/condition"
+	"github.com/openshift/gcp-project-operator/pkg/configmap"
+	"github.com/openshift/gcp-project-operator/pkg/events"
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
 	"github.com/openshift/gcp-project-operator/pkg/util"
 	gcputil "github.com/openshift/gcp-project-operator/pkg/util"
+	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -21,19 +34,147 @@ import (
 
 var log = logf.Log.WithName("controller_projectclaim")
 
+// watchNamespaces holds the namespace allowlist for the claim controller.
+// An empty list keeps the controller watching all namespaces.
+var watchNamespaces []string
+
+// SetWatchNamespaces restricts the ProjectClaim controller to claims in the given namespaces.
+// Claims in other namespaces are ignored entirely: no ProjectReference is created and no
+// status is written. ProjectReference CRs are still created in the ProjectReferenceNamespace,
+// which does not need to be part of the allowlist.
+func SetWatchNamespaces(namespaces []string) {
+	watchNamespaces = namespaces
+}
+
+// validateOnly holds the operator-wide validate-only switch for the claim controller.
+var validateOnly bool
+
+// SetValidateOnly toggles validate-only mode for the ProjectClaim controller.
+// In validate-only mode validations still run and conditions are reported,
+// but no ProjectReference is created or modified.
+func SetValidateOnly(enabled bool) {
+	validateOnly = enabled
+}
+
+func namespaceAllowed(namespace string) bool {
+	if len(watchNamespaces) == 0 {
+		return true
+	}
+	return clusterapi.Contains(watchNamespaces, namespace)
+}
+
+// namespacePredicate filters out events for claims outside the namespace allowlist
+func namespacePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return namespaceAllowed(e.Meta.GetNamespace()) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return namespaceAllowed(e.MetaNew.GetNamespace()) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return namespaceAllowed(e.Meta.GetNamespace()) },
+		GenericFunc: func(e event.GenericEvent) bool { return namespaceAllowed(e.Meta.GetNamespace()) },
+	}
+}
+
+// operatorConfigMapPredicate filters ConfigMap events down to the operator's own config
+func operatorConfigMapPredicate() predicate.Predicate {
+	matches := func(name, namespace string) bool {
+		return name == configmap.OperatorConfigMapName && namespace == configmap.OperatorConfigMapNamespace
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return matches(e.Meta.GetName(), e.Meta.GetNamespace()) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return matches(e.MetaNew.GetName(), e.MetaNew.GetNamespace()) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return matches(e.Meta.GetName(), e.Meta.GetNamespace()) },
+		GenericFunc: func(e event.GenericEvent) bool { return matches(e.Meta.GetName(), e.Meta.GetNamespace()) },
+	}
+}
+
+// NewOperatorConfigMapMapper returns a mapper that enqueues every ProjectClaim whenever
+// the operator ConfigMap changes, so updated defaults and validations apply to existing claims.
+// Claims with a higher Spec.Priority are enqueued first.
+func NewOperatorConfigMapMapper(kubeClient client.Client) handler.Mapper {
+	return handler.ToRequestsFunc(func(mapObject handler.MapObject) []reconcile.Request {
+		claimList := &gcpv1alpha1.ProjectClaimList{}
+		if err := kubeClient.List(context.TODO(), &client.ListOptions{}, claimList); err != nil {
+			log.Error(err, "could not list ProjectClaims to react to an operator config change")
+			return nil
+		}
+
+		ordered := claimsByPriority(claimList.Items)
+		requests := make([]reconcile.Request, 0, len(ordered))
+		for _, claim := range ordered {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: claim.GetName(), Namespace: claim.GetNamespace()},
+			})
+		}
+		return requests
+	})
+}
+
+// claimsByPriority returns the given claims in enqueueing order: claims with a
+// higher Spec.Priority come first, equal priorities keep their list order.
+func claimsByPriority(claims []gcpv1alpha1.ProjectClaim) []gcpv1alpha1.ProjectClaim {
+	queue := NewPriorityQueue()
+	byName := map[types.NamespacedName]gcpv1alpha1.ProjectClaim{}
+	for _, claim := range claims {
+		name := types.NamespacedName{Name: claim.GetName(), Namespace: claim.GetNamespace()}
+		byName[name] = claim
+		queue.Add(reconcile.Request{NamespacedName: name}, claim.Spec.Priority)
+	}
+	ordered := make([]gcpv1alpha1.ProjectClaim, 0, queue.Len())
+	for request, ok := queue.Pop(); ok; request, ok = queue.Pop() {
+		ordered = append(ordered, byName[request.NamespacedName])
+	}
+	return ordered
+}
+
+// EnqueueClaimsByPriority pushes a generic event for every ProjectClaim into
+// the given channel, higher-priority claims first. It backs the cold-start
+// re-enqueue wired up in add and returns early when the stop channel closes.
+func EnqueueClaimsByPriority(kubeClient client.Client, events chan<- event.GenericEvent, stop <-chan struct{}) error {
+	claimList := &gcpv1alpha1.ProjectClaimList{}
+	if err := kubeClient.List(context.TODO(), &client.ListOptions{}, claimList); err != nil {
+		log.Error(err, "could not list ProjectClaims for the cold-start re-enqueue")
+		return nil
+	}
+	ordered := claimsByPriority(claimList.Items)
+	for i := range ordered {
+		select {
+		case events <- event.GenericEvent{Meta: &ordered[i], Object: &ordered[i]}:
+		case <-stop:
+			return nil
+		}
+	}
+	return nil
+}
+
 //go:generate mockgen -destination=../../util/mocks/$GOPACKAGE/customeresourceadapter.go -package=$GOPACKAGE github.com/openshift/gcp-project-operator/pkg/controller/projectclaim CustomResourceAdapter
 type CustomResourceAdapter interface {
 	EnsureProjectClaimDeletionProcessed() (gcputil.OperationResult, error)
+	EnsureNamespaceActive() (gcputil.OperationResult, error)
 	ProjectReferenceExists() (bool, error)
 	EnsureProjectClaimInitialized() (gcputil.OperationResult, error)
+	EnsureAnnotationConditions() (gcputil.OperationResult, error)
+	EnsureDrainProcessed() (gcputil.OperationResult, error)
 	EnsureProjectClaimStatePending() (gcputil.OperationResult, error)
 	EnsureProjectClaimStatePendingProject() (gcputil.OperationResult, error)
+	EnsureDependenciesReady() (gcputil.OperationResult, error)
 	EnsureRegionSupported() (gcputil.OperationResult, error)
+	EnsureSpecConsistent() (gcputil.OperationResult, error)
+	EnsureRequiredLabelsPresent() (gcputil.OperationResult, error)
+	EnsureParentValid() (gcputil.OperationResult, error)
+	EnsureDisplayNameValid() (gcputil.OperationResult, error)
+	EnsureServicePerimeterValid() (gcputil.OperationResult, error)
+	EnsureLegalEntityBillingApplied() (gcputil.OperationResult, error)
+	EnsureTemplateApplied() (gcputil.OperationResult, error)
+	EnsureProjectLimitNotReached() (gcputil.OperationResult, error)
 	EnsureProjectReferenceExists() (gcputil.OperationResult, error)
+	EnsureProjectReferenceSpecApplied() (gcputil.OperationResult, error)
 	EnsureProjectReferenceLink() (gcputil.OperationResult, error)
+	EnsureLinkConsistency() (gcputil.OperationResult, error)
 	EnsureFinalizer() (gcputil.OperationResult, error)
+	EnsureCredentialSecretPresent() (gcputil.OperationResult, error)
+	EnsureCredentialSecretCopied() (gcputil.OperationResult, error)
 	FinalizeProjectClaim() (ObjectState, error)
 	SetProjectClaimCondition(reason string, err error) error
+	SetLastReconcileTime() error
 }
 
 // Add creates a new ProjectClaim Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -44,7 +185,7 @@ func Add(mgr manager.Manager) error {
 
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileProjectClaim{client: mgr.GetClient(), scheme: mgr.GetScheme()}
+	return &ReconcileProjectClaim{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: events.NewThrottledRecorder(mgr.GetRecorder("projectclaim-controller"))}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -56,12 +197,37 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	}
 
 	// Watch for changes to primary resource ProjectClaim
-	err = c.Watch(&source.Kind{Type: &gcpv1alpha1.ProjectClaim{}}, &handler.EnqueueRequestForObject{})
+	err = c.Watch(&source.Kind{Type: &gcpv1alpha1.ProjectClaim{}}, &handler.EnqueueRequestForObject{}, namespacePredicate())
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// Watch the copied credential Secrets (owned by their claim) so a deleted
+	// secret requeues the claim and gets restored
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{IsController: true, OwnerType: &gcpv1alpha1.ProjectClaim{}}, namespacePredicate())
+	if err != nil {
+		return err
+	}
+
+	// Watch the operator ConfigMap so config changes re-reconcile existing claims
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: NewOperatorConfigMapMapper(mgr.GetClient())}, operatorConfigMapPredicate())
+	if err != nil {
+		return err
+	}
+
+	// Re-enqueue every claim once the caches have synced, so a cold start
+	// works through higher-priority claims first
+	coldStartEvents := make(chan event.GenericEvent)
+	err = c.Watch(&source.Channel{Source: coldStartEvents}, &handler.EnqueueRequestForObject{}, namespacePredicate())
+	if err != nil {
+		return err
+	}
+	return mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		if ok := mgr.GetCache().WaitForCacheSync(stop); !ok {
+			return nil
+		}
+		return EnqueueClaimsByPriority(mgr.GetClient(), coldStartEvents, stop)
+	}))
 }
 
 // blank assignment to verify that ReconcileProjectClaim implements reconcile.Reconciler
@@ -71,18 +237,24 @@ var _ reconcile.Reconciler = &ReconcileProjectClaim{}
 type ReconcileProjectClaim struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client client.Client
-	scheme *runtime.Scheme
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
 }
 
 func NewReconcileProjectClaim(client client.Client, scheme *runtime.Scheme) *ReconcileProjectClaim {
-	return &ReconcileProjectClaim{client, scheme}
+	return &ReconcileProjectClaim{client: client, scheme: scheme}
 }
 
 // Reconcile calls ReconcileHandler and updates the CRD if any err occurs
 func (r *ReconcileProjectClaim) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
+	if !namespaceAllowed(request.Namespace) {
+		reqLogger.V(2).Info("Namespace not in the watch allowlist, skipping reconcile")
+		return r.doNotRequeue()
+	}
+
 	// Fetch the ProjectClaim instance
 	instance := &gcpv1alpha1.ProjectClaim{}
 	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
@@ -95,13 +267,88 @@ func (r *ReconcileProjectClaim) Reconcile(request reconcile.Request) (reconcile.
 		return r.requeueOnErr(err)
 	}
 
+	// Deletions are still processed while the kill-switch is active
+	if instance.DeletionTimestamp == nil && !configmap.ReconcileEnabled(r.client) {
+		localmetrics.ReconcileEnabled.Set(0)
+		reqLogger.Info("Reconciliation is paused via the reconcile.enabled kill-switch")
+		if r.recorder != nil {
+			r.recorder.Event(instance, corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused via the reconcile.enabled key of the operator ConfigMap")
+		}
+		return r.doNotRequeue()
+	}
+	localmetrics.ReconcileEnabled.Set(1)
+
 	conditionManager := condition.NewConditionManager()
 	adapter := NewProjectClaimAdapter(instance, reqLogger, r.client, conditionManager)
-	result, err := r.ReconcileHandler(adapter)
+	result, err := r.ReconcileHandler(adapter, request.NamespacedName)
 	reason := "ReconcileError"
+	if goerrors.Is(err, operrors.ErrInvalidParent) {
+		reason = "InvalidParent"
+	}
+	if goerrors.Is(err, operrors.ErrInconsistentSpec) {
+		reason = "InconsistentSpec"
+	}
+	if goerrors.Is(err, operrors.ErrMissingRequiredLabels) {
+		reason = "MissingRequiredLabels"
+	}
+	if goerrors.Is(err, operrors.ErrMismatchedProjectReference) {
+		reason = "MismatchedProjectReference"
+	}
+	if goerrors.Is(err, operrors.ErrProjectLimitReached) {
+		reason = ReasonProjectLimitReached
+	}
 	_ = adapter.SetProjectClaimCondition(reason, err)
 
-	return result, err
+	return ApplyReasonRequeuePolicy(reason, result, err)
+}
+
+// RequeuePolicy decides how a reconcile that failed with a given condition
+// reason is retried.
+type RequeuePolicy struct {
+	// NoRequeue drops the error entirely, so the claim is only reconciled
+	// again when it changes. Suitable for invalid specs that cannot succeed
+	// until a human fixes them.
+	NoRequeue bool
+	// FixedDelay retries after the given delay instead of the workqueue's
+	// exponential backoff. Ignored when NoRequeue is set.
+	FixedDelay time.Duration
+}
+
+// reasonRequeuePolicies maps condition reasons to retry policies. Reasons
+// without an entry keep the workqueue's exponential backoff, which starts
+// fast and is the right cadence for transient failures.
+var reasonRequeuePolicies = map[string]RequeuePolicy{
+	"InvalidParent":              {NoRequeue: true},
+	"InconsistentSpec":           {NoRequeue: true},
+	"MismatchedProjectReference": {NoRequeue: true},
+	"MissingRequiredLabels":      {NoRequeue: true},
+	"QuotaExhausted":             {FixedDelay: 10 * time.Minute},
+	// Retrying sooner cannot succeed until a project of the legal entity is
+	// deleted or the limit is raised
+	"ProjectLimitReached": {FixedDelay: 10 * time.Minute},
+}
+
+// SetReasonRequeuePolicy overrides the retry policy for a condition reason.
+func SetReasonRequeuePolicy(reason string, policy RequeuePolicy) {
+	reasonRequeuePolicies[reason] = policy
+}
+
+// ApplyReasonRequeuePolicy rewrites the reconcile result of a failed
+// reconcile according to the retry policy of its condition reason.
+func ApplyReasonRequeuePolicy(reason string, result reconcile.Result, err error) (reconcile.Result, error) {
+	if err == nil {
+		return result, nil
+	}
+	policy, found := reasonRequeuePolicies[reason]
+	if !found {
+		return result, err
+	}
+	if policy.NoRequeue {
+		return reconcile.Result{}, nil
+	}
+	// Returning the error would put the claim on the workqueue's backoff;
+	// dropping it makes the fixed delay authoritative.
+	return reconcile.Result{RequeueAfter: policy.FixedDelay}, nil
 }
 
 type ReconcileOperation func() (util.OperationResult, error)
@@ -111,19 +358,71 @@ type ReconcileOperation func() (util.OperationResult, error)
 // Note:
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
-func (r *ReconcileProjectClaim) ReconcileHandler(adapter CustomResourceAdapter) (reconcile.Result, error) {
+func (r *ReconcileProjectClaim) ReconcileHandler(adapter CustomResourceAdapter, claim types.NamespacedName) (result reconcile.Result, err error) {
+	// Stamp the last successful reconcile time so monitoring can alert on
+	// stale claims. Failed reconciles leave the timestamp untouched.
+	defer func() {
+		if err == nil {
+			if updateErr := adapter.SetLastReconcileTime(); updateErr != nil {
+				log.Error(updateErr, "could not record the last reconcile time")
+			}
+		}
+	}()
+	var decision *ReconcileDecision
+	if reconcileDebugEnabled {
+		decision = &ReconcileDecision{Time: time.Now(), Claim: claim.String()}
+		defer func() { recordReconcileDecision(*decision) }()
+	}
 	operations := []ReconcileOperation{
 		adapter.EnsureProjectClaimDeletionProcessed,
+		adapter.EnsureNamespaceActive,
 		adapter.EnsureProjectClaimInitialized,
+		adapter.EnsureAnnotationConditions,
+		adapter.EnsureDrainProcessed,
 		adapter.EnsureRegionSupported,
+		adapter.EnsureSpecConsistent,
+		adapter.EnsureRequiredLabelsPresent,
+		adapter.EnsureParentValid,
+		adapter.EnsureDisplayNameValid,
+		adapter.EnsureServicePerimeterValid,
 		adapter.EnsureProjectClaimStatePending,
+		adapter.EnsureDependenciesReady,
+		adapter.EnsureLegalEntityBillingApplied,
+		adapter.EnsureTemplateApplied,
+		adapter.EnsureProjectLimitNotReached,
 		adapter.EnsureProjectReferenceExists,
+		adapter.EnsureProjectReferenceSpecApplied,
 		adapter.EnsureProjectReferenceLink,
+		adapter.EnsureLinkConsistency,
 		adapter.EnsureFinalizer,
 		adapter.EnsureProjectClaimStatePendingProject,
+		adapter.EnsureCredentialSecretPresent,
+		adapter.EnsureCredentialSecretCopied,
+	}
+	if validateOnly {
+		// Validate-only mode reports validation results without creating or
+		// modifying ProjectReferences. Deletion processing stays in the list
+		// so claims deleted while the flag is on still get their finalizer
+		// removed instead of stranding in Terminating.
+		operations = []ReconcileOperation{
+			adapter.EnsureProjectClaimDeletionProcessed,
+			adapter.EnsureRegionSupported,
+			adapter.EnsureSpecConsistent,
+			adapter.EnsureRequiredLabelsPresent,
+			adapter.EnsureParentValid,
+			adapter.EnsureDisplayNameValid,
+			adapter.EnsureServicePerimeterValid,
+		}
 	}
 	for _, operation := range operations {
 		result, err := operation()
+		if decision != nil {
+			entry := OperationDecision{Operation: operationName(operation), Requeue: result.RequeueRequest, Cancel: result.CancelRequest}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+			decision.Operations = append(decision.Operations, entry)
+		}
 		if err != nil || result.RequeueRequest {
 			return r.requeueAfter(result.RequeueDelay, err)
 		}
@@ -131,9 +430,45 @@ func (r *ReconcileProjectClaim) ReconcileHandler(adapter CustomResourceAdapter)
 			return r.doNotRequeue()
 		}
 	}
+	if reconcileInvariants {
+		for _, name := range CheckReconcileInvariants(operations) {
+			log.Info("Reconcile operation is not idempotent: it reported further mutations on an immediate re-run", "operation", name)
+		}
+	}
 	return r.doNotRequeue()
 }
 
+// reconcileInvariants enables the idempotency check below after every clean
+// reconcile pass. Enabled via the --reconcile-invariants flag; off by default
+// because it doubles the work of each reconcile.
+var reconcileInvariants bool
+
+// SetReconcileInvariants toggles the reconcile idempotency check.
+func SetReconcileInvariants(enabled bool) {
+	reconcileInvariants = enabled
+}
+
+// CheckReconcileInvariants runs each operation once more and returns the names
+// of those that still report mutations. Directly after a clean pass every
+// operation must be a no-op; anything else indicates a double-apply bug in
+// that Ensure method.
+func CheckReconcileInvariants(operations []ReconcileOperation) []string {
+	violations := []string{}
+	for _, operation := range operations {
+		result, err := operation()
+		if err != nil || result.RequeueRequest || result.CancelRequest {
+			violations = append(violations, operationName(operation))
+		}
+	}
+	return violations
+}
+
+// operationName resolves the function name behind a ReconcileOperation for
+// the idempotency violation log.
+func operationName(operation ReconcileOperation) string {
+	return goruntime.FuncForPC(reflect.ValueOf(operation).Pointer()).Name()
+}
+
 func (r *ReconcileProjectClaim) doNotRequeue() (reconcile.Result, error) {
 	return reconcile.Result{}, nil
 }
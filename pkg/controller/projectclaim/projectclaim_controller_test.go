@@ -1,18 +1,25 @@
 package projectclaim_test
 
 import (
+	"context"
+	er "errors"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	. "github.com/openshift/gcp-project-operator/pkg/controller/projectclaim"
 	gcputil "github.com/openshift/gcp-project-operator/pkg/util"
 	testStructs "github.com/openshift/gcp-project-operator/pkg/util/mocks/structs"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/openshift/gcp-project-operator/pkg/util/mocks"
@@ -44,6 +51,42 @@ var _ = Describe("ProjectclaimController", func() {
 			projectClaimName     types.NamespacedName
 			projectReferenceName types.NamespacedName
 		)
+		Context("When the ProjectClaim namespace is not in the watch allowlist", func() {
+			BeforeEach(func() {
+				SetWatchNamespaces([]string{"allowed-namespace"})
+				projectClaimName = types.NamespacedName{
+					Name:      testStructs.TestProjectClaimName,
+					Namespace: testStructs.TestNamespace,
+				}
+			})
+			AfterEach(func() {
+				SetWatchNamespaces(nil)
+			})
+			It("Returns without reconciling the claim", func() {
+				_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectClaimName})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("When the reconcile kill-switch is active", func() {
+			BeforeEach(func() {
+				projectClaimName = types.NamespacedName{
+					Name:      testStructs.TestProjectClaimName,
+					Namespace: testStructs.TestNamespace,
+				}
+				claim := testStructs.NewProjectClaimBuilder().GetProjectClaim()
+				mockClient.EXPECT().Get(gomock.Any(), projectClaimName, gomock.Any()).SetArg(2, *claim)
+				mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Name: "gcp-project-operator", Namespace: "gcp-project-operator"}, gomock.Any()).SetArg(2, corev1.ConfigMap{
+					Data: map[string]string{"reconcile.enabled": "false"},
+				})
+			})
+			It("Returns without reconciling the claim", func() {
+				res, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectClaimName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.Requeue).To(Equal(false))
+			})
+		})
+
 		Context("When the ProjectClaim does not exist", func() {
 			BeforeEach(func() {
 				projectClaimName = types.NamespacedName{
@@ -65,24 +108,194 @@ var _ = Describe("ProjectclaimController", func() {
 
 	})
 
+	Context("OperatorConfigMapMapper", func() {
+		It("Enqueues all existing claims when the operator ConfigMap changes", func() {
+			claimList := gcpv1alpha1.ProjectClaimList{
+				Items: []gcpv1alpha1.ProjectClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-a", Namespace: "ns-a"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-b", Namespace: "ns-b"}},
+				},
+			}
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, claimList)
+
+			requests := NewOperatorConfigMapMapper(mockClient).Map(handler.MapObject{})
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].NamespacedName).To(Equal(types.NamespacedName{Name: "claim-a", Namespace: "ns-a"}))
+			Expect(requests[1].NamespacedName).To(Equal(types.NamespacedName{Name: "claim-b", Namespace: "ns-b"}))
+		})
+
+		It("Enqueues higher-priority claims first", func() {
+			claimList := gcpv1alpha1.ProjectClaimList{
+				Items: []gcpv1alpha1.ProjectClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-dev", Namespace: "ns-a"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-prod", Namespace: "ns-b"}, Spec: gcpv1alpha1.ProjectClaimSpec{Priority: 10}},
+				},
+			}
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, claimList)
+
+			requests := NewOperatorConfigMapMapper(mockClient).Map(handler.MapObject{})
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].NamespacedName).To(Equal(types.NamespacedName{Name: "claim-prod", Namespace: "ns-b"}))
+			Expect(requests[1].NamespacedName).To(Equal(types.NamespacedName{Name: "claim-dev", Namespace: "ns-a"}))
+		})
+
+		It("Enqueues nothing when listing claims fails", func() {
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.NewBadRequest("fake error"))
+
+			requests := NewOperatorConfigMapMapper(mockClient).Map(handler.MapObject{})
+			Expect(requests).To(BeEmpty())
+		})
+	})
+
+	Context("EnqueueClaimsByPriority", func() {
+		It("Sends higher-priority claims through the channel first", func() {
+			claimList := gcpv1alpha1.ProjectClaimList{
+				Items: []gcpv1alpha1.ProjectClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-dev", Namespace: "ns-a"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-prod", Namespace: "ns-b"}, Spec: gcpv1alpha1.ProjectClaimSpec{Priority: 10}},
+				},
+			}
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, claimList)
+
+			events := make(chan event.GenericEvent, len(claimList.Items))
+			Expect(EnqueueClaimsByPriority(mockClient, events, make(chan struct{}))).To(Succeed())
+			close(events)
+
+			names := []string{}
+			for evt := range events {
+				names = append(names, evt.Meta.GetName())
+			}
+			Expect(names).To(Equal([]string{"claim-prod", "claim-dev"}))
+		})
+
+		It("Stops sending when the stop channel closes", func() {
+			claimList := gcpv1alpha1.ProjectClaimList{
+				Items: []gcpv1alpha1.ProjectClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-a", Namespace: "ns-a"}},
+				},
+			}
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, claimList)
+
+			stop := make(chan struct{})
+			close(stop)
+			Expect(EnqueueClaimsByPriority(mockClient, make(chan event.GenericEvent), stop)).To(Succeed())
+		})
+
+		It("Sends nothing when listing claims fails", func() {
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.NewBadRequest("fake error"))
+
+			events := make(chan event.GenericEvent, 1)
+			Expect(EnqueueClaimsByPriority(mockClient, events, make(chan struct{}))).To(Succeed())
+			Expect(events).To(BeEmpty())
+		})
+	})
+
+	Context("FinalizationProgress", func() {
+		It("counts terminating and stuck claims", func() {
+			recent := metav1.Now()
+			old := metav1.NewTime(time.Now().Add(-time.Hour))
+			claimList := gcpv1alpha1.ProjectClaimList{
+				Items: []gcpv1alpha1.ProjectClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-active", Namespace: "ns-a"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-terminating", Namespace: "ns-a", DeletionTimestamp: &recent}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "claim-stuck", Namespace: "ns-b", DeletionTimestamp: &old}},
+				},
+			}
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, claimList)
+
+			total, terminating, stuck, err := FinalizationProgress(context.TODO(), mockClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(3))
+			Expect(terminating).To(Equal(2))
+			Expect(stuck).To(Equal(1))
+		})
+
+		It("propagates list errors", func() {
+			mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.NewBadRequest("fake error"))
+
+			_, _, _, err := FinalizationProgress(context.TODO(), mockClient)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("PriorityQueue", func() {
+		request := func(name string) reconcile.Request {
+			return reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: testStructs.TestNamespace}}
+		}
+
+		It("Dequeues higher-priority requests first", func() {
+			queue := NewPriorityQueue()
+			queue.Add(request("low"), 0)
+			queue.Add(request("high"), 5)
+			queue.Add(request("medium"), 1)
+
+			popped := []string{}
+			for req, ok := queue.Pop(); ok; req, ok = queue.Pop() {
+				popped = append(popped, req.Name)
+			}
+			Expect(popped).To(Equal([]string{"high", "medium", "low"}))
+		})
+
+		It("Preserves insertion order for equal priorities", func() {
+			queue := NewPriorityQueue()
+			queue.Add(request("first"), 0)
+			queue.Add(request("second"), 0)
+			queue.Add(request("third"), 0)
+
+			popped := []string{}
+			for req, ok := queue.Pop(); ok; req, ok = queue.Pop() {
+				popped = append(popped, req.Name)
+			}
+			Expect(popped).To(Equal([]string{"first", "second", "third"}))
+		})
+
+		It("Reports empty when drained", func() {
+			queue := NewPriorityQueue()
+			queue.Add(request("only"), 0)
+			_, ok := queue.Pop()
+			Expect(ok).To(BeTrue())
+			_, ok = queue.Pop()
+			Expect(ok).To(BeFalse())
+			Expect(queue.Len()).To(Equal(0))
+		})
+	})
+
 	Context("ReconcileHandler", func() {
 		var (
-			mockAdapter *mockclaim.MockCustomResourceAdapter
+			mockAdapter      *mockclaim.MockCustomResourceAdapter
+			projectClaimName = types.NamespacedName{
+				Name:      testStructs.TestProjectClaimName,
+				Namespace: testStructs.TestNamespace,
+			}
 		)
 		Context("When the ProjectClaim is newly created", func() {
 			BeforeEach(func() {
 				mockAdapter = mockclaim.NewMockCustomResourceAdapter(mockCtrl)
 				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureNamespaceActive().Return(gcputil.ContinueProcessing())
 				mockAdapter.EXPECT().EnsureRegionSupported().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureSpecConsistent().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureRequiredLabelsPresent().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureParentValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureDisplayNameValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureServicePerimeterValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureLegalEntityBillingApplied().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureTemplateApplied().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureProjectLimitNotReached().Return(gcputil.ContinueProcessing())
 				mockAdapter.EXPECT().EnsureProjectReferenceExists().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureProjectReferenceSpecApplied().Return(gcputil.ContinueProcessing())
 				mockAdapter.EXPECT().EnsureProjectClaimInitialized().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureAnnotationConditions().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureDrainProcessed().Return(gcputil.ContinueProcessing())
 				mockAdapter.EXPECT().EnsureProjectClaimStatePending().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureDependenciesReady().Return(gcputil.ContinueProcessing())
 			})
 
 			Context("When the ProjectReferenceLink does not exist", func() {
 				It("Creates a ProjectReference, Links reference, sets status to Pending, and does not requeue", func() {
 					mockAdapter.EXPECT().EnsureProjectReferenceLink().Return(gcputil.StopProcessing())
-					res, err := reconciler.ReconcileHandler(mockAdapter)
+					mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+					res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(res.Requeue).To(Equal(false))
 					Expect(res.RequeueAfter).To(Equal(0 * time.Second))
@@ -92,12 +305,13 @@ var _ = Describe("ProjectclaimController", func() {
 			Context("When the ProjectReferenceLink exists", func() {
 				BeforeEach(func() {
 					mockAdapter.EXPECT().EnsureProjectReferenceLink().Return(gcputil.ContinueProcessing())
-
+					mockAdapter.EXPECT().EnsureLinkConsistency().Return(gcputil.ContinueProcessing())
 				})
 				Context("When the Finalizer does not exist", func() {
 					It("Adds the finalizer and does not requeue", func() {
 						mockAdapter.EXPECT().EnsureFinalizer().Return(gcputil.StopProcessing())
-						res, err := reconciler.ReconcileHandler(mockAdapter)
+						mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+						res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(res.Requeue).To(Equal(false))
 						Expect(res.RequeueAfter).To(Equal(0 * time.Second))
@@ -111,7 +325,10 @@ var _ = Describe("ProjectclaimController", func() {
 
 					It("Sets the state to PendingProject", func() {
 						mockAdapter.EXPECT().EnsureProjectClaimStatePendingProject()
-						res, err := reconciler.ReconcileHandler(mockAdapter)
+						mockAdapter.EXPECT().EnsureCredentialSecretPresent().Return(gcputil.ContinueProcessing())
+						mockAdapter.EXPECT().EnsureCredentialSecretCopied().Return(gcputil.ContinueProcessing())
+						mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+						res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(res.Requeue).To(Equal(false))
 						Expect(res.RequeueAfter).To(Equal(0 * time.Second))
@@ -124,8 +341,156 @@ var _ = Describe("ProjectclaimController", func() {
 
 			It("finalizes the projectclaim", func() {
 				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.StopProcessing())
-				_, err := reconciler.ReconcileHandler(mockAdapter)
+				mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+				_, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("When the claim's namespace is terminating", func() {
+
+			It("short-circuits without erroring", func() {
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureNamespaceActive().Return(gcputil.StopProcessing())
+				mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+				res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Requeue).To(BeFalse())
+			})
+		})
+
+		Context("When an operation fails", func() {
+
+			It("does not record the last reconcile time", func() {
+				mockAdapter = mockclaim.NewMockCustomResourceAdapter(mockCtrl)
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.RequeueWithError(er.New("fake reconcile error")))
+				_, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("ApplyReasonRequeuePolicy", func() {
+			fakeError := er.New("fake reconcile error")
+
+			It("does not requeue invalid-spec reasons", func() {
+				res, err := ApplyReasonRequeuePolicy("InvalidParent", reconcile.Result{}, fakeError)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(reconcile.Result{}))
+			})
+
+			It("retries quota failures after a long fixed delay", func() {
+				res, err := ApplyReasonRequeuePolicy("QuotaExhausted", reconcile.Result{}, fakeError)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(10 * time.Minute))
+			})
+
+			It("keeps the workqueue backoff for unmapped reasons", func() {
+				res, err := ApplyReasonRequeuePolicy("ReconcileError", reconcile.Result{}, fakeError)
+				Expect(err).To(Equal(fakeError))
+				Expect(res).To(Equal(reconcile.Result{}))
+			})
+
+			It("passes successful results through unchanged", func() {
+				res, err := ApplyReasonRequeuePolicy("InvalidParent", reconcile.Result{RequeueAfter: time.Minute}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(time.Minute))
+			})
+
+			It("honors an operator-configured override", func() {
+				SetReasonRequeuePolicy("FakeTransient", RequeuePolicy{FixedDelay: time.Minute})
+				res, err := ApplyReasonRequeuePolicy("FakeTransient", reconcile.Result{}, fakeError)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(time.Minute))
+			})
+		})
+
+		Context("CheckReconcileInvariants", func() {
+			idempotentOperation := func() (gcputil.OperationResult, error) {
+				return gcputil.ContinueProcessing()
+			}
+
+			It("reports nothing when every operation is a no-op on re-run", func() {
+				violations := CheckReconcileInvariants([]ReconcileOperation{idempotentOperation, idempotentOperation})
+				Expect(violations).To(BeEmpty())
+			})
+
+			It("reports an operation that still mutates on re-run", func() {
+				nonIdempotentOperation := func() (gcputil.OperationResult, error) {
+					return gcputil.StopProcessing()
+				}
+				violations := CheckReconcileInvariants([]ReconcileOperation{idempotentOperation, nonIdempotentOperation})
+				Expect(violations).To(HaveLen(1))
+				Expect(violations[0]).NotTo(BeEmpty())
+			})
+		})
+
+		Context("When reconcile debugging is enabled", func() {
+			BeforeEach(func() {
+				mockAdapter = mockclaim.NewMockCustomResourceAdapter(mockCtrl)
+				SetReconcileDebug(true)
+			})
+			AfterEach(func() {
+				SetReconcileDebug(false)
+			})
+
+			It("appends a decision record retrievable from the ring buffer", func() {
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.StopProcessing())
+				mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+				_, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).ToNot(HaveOccurred())
+
+				decisions := RecentReconcileDecisions()
+				Expect(decisions).ToNot(BeEmpty())
+				decision := decisions[len(decisions)-1]
+				Expect(decision.Claim).To(Equal(projectClaimName.String()))
+				Expect(decision.Time).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(decision.Operations).To(HaveLen(1))
+				Expect(decision.Operations[0].Operation).To(ContainSubstring("EnsureProjectClaimDeletionProcessed"))
+				Expect(decision.Operations[0].Cancel).To(BeTrue())
+			})
+
+			It("records the error of a failing operation", func() {
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.RequeueWithError(er.New("fake reconcile error")))
+				_, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).To(HaveOccurred())
+
+				decisions := RecentReconcileDecisions()
+				decision := decisions[len(decisions)-1]
+				Expect(decision.Operations[0].Error).To(ContainSubstring("fake reconcile error"))
+				Expect(decision.Operations[0].Requeue).To(BeTrue())
+			})
+		})
+
+		Context("When the operator runs in validate-only mode", func() {
+			BeforeEach(func() {
+				mockAdapter = mockclaim.NewMockCustomResourceAdapter(mockCtrl)
+				SetValidateOnly(true)
+			})
+			AfterEach(func() {
+				SetValidateOnly(false)
+			})
+
+			It("Runs validations without creating or modifying ProjectReferences", func() {
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureRegionSupported().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureSpecConsistent().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureRequiredLabelsPresent().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureParentValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureDisplayNameValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().EnsureServicePerimeterValid().Return(gcputil.ContinueProcessing())
+				mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+				res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Requeue).To(Equal(false))
+				Expect(res.RequeueAfter).To(Equal(0 * time.Second))
+			})
+
+			It("Still processes the deletion of a claim", func() {
+				mockAdapter.EXPECT().EnsureProjectClaimDeletionProcessed().Return(gcputil.StopProcessing())
+				mockAdapter.EXPECT().SetLastReconcileTime().Return(nil)
+				res, err := reconciler.ReconcileHandler(mockAdapter, projectClaimName)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Requeue).To(Equal(false))
 			})
 		})
 	})
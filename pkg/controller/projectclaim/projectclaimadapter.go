@@ -1,8 +1,13 @@
 package projectclaim
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -10,6 +15,9 @@ import (
 
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	condition "github.com/openshift/gcp-project-operator/pkg/condition"
+	"github.com/openshift/gcp-project-operator/pkg/configmap"
+	"github.com/openshift/gcp-project-operator/pkg/controller/projectreference"
+	"github.com/openshift/gcp-project-operator/pkg/errorbudget"
 	gcputil "github.com/openshift/gcp-project-operator/pkg/util"
 	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
 
@@ -37,6 +45,45 @@ const (
 
 const ProjectClaimFinalizer string = "finalizer.gcp.managed.openshift.io"
 
+// projectClaimFinalizerName is the finalizer placed on ProjectClaims. It is
+// configurable so the operator can coexist with an older operator that used a
+// different finalizer string during migrations.
+var projectClaimFinalizerName = ProjectClaimFinalizer
+
+// SetProjectClaimFinalizerName overrides the finalizer name placed on
+// ProjectClaims. An empty name restores the default.
+func SetProjectClaimFinalizerName(name string) {
+	if name == "" {
+		projectClaimFinalizerName = ProjectClaimFinalizer
+		return
+	}
+	projectClaimFinalizerName = name
+}
+
+const (
+	// PendingDiffAnnotation holds a preview of the ProjectReference spec changes the operator would apply
+	PendingDiffAnnotation string = "gcp.managed.openshift.io/pending-diff"
+	// ApproveAnnotation allows applying a pending ProjectReference spec diff when set to "true"
+	ApproveAnnotation string = "gcp.managed.openshift.io/approve"
+	// pendingDiffMaxLength is the maximum length of the published diff before truncation
+	pendingDiffMaxLength int = 1024
+	// ClaimUIDAnnotation records the UID of the claim a ProjectReference was created for,
+	// so a recreated claim (same name/namespace, new UID) is not served by the stale reference
+	ClaimUIDAnnotation string = "gcp.managed.openshift.io/claim-uid"
+	// StateHistoryAnnotation records the last claim state transitions as state@timestamp
+	// pairs. Unlike the status it survives status resets, which helps post-mortems.
+	StateHistoryAnnotation string = "gcp.managed.openshift.io/state-history"
+	// ReferenceNameAnnotation binds the claim to a specific ProjectReference
+	// name instead of the computed namespace-name, for migrations onto
+	// pre-existing references
+	ReferenceNameAnnotation string = "gcp.managed.openshift.io/reference-name"
+	// stateHistoryMaxEntries caps the number of transitions kept in the history annotation
+	stateHistoryMaxEntries int = 10
+	// projectRecoveryWindow is roughly how long GCP keeps a deleted project
+	// in its pending-deletion state before purging it
+	projectRecoveryWindow = 30 * 24 * time.Hour
+)
+
 // Regions supported in the gcp-project-operator
 var supportedRegions = map[string]bool{
 	"asia-east1":      true,
@@ -73,16 +120,145 @@ func NewProjectClaimAdapter(projectClaim *gcpv1alpha1.ProjectClaim, logger logr.
 	return &ProjectClaimAdapter{projectClaim, logger, client, projectReference, manager}
 }
 
+// referenceAnnotationTemplates holds the operator-configured templates that
+// render additional ProjectReference annotations from claim fields.
+var referenceAnnotationTemplates map[string]*template.Template
+
+// SetReferenceAnnotationTemplates configures templates rendering additional
+// annotations on new ProjectReferences from their claim, keyed by annotation
+// name (e.g. "team" -> "{{.Spec.Labels.team}}"). Templates are parsed
+// immediately so invalid ones are rejected at operator startup. A nil or empty
+// map clears the set.
+func SetReferenceAnnotationTemplates(templates map[string]string) error {
+	if len(templates) == 0 {
+		referenceAnnotationTemplates = nil
+		return nil
+	}
+	parsed := map[string]*template.Template{}
+	for annotation, tmpl := range templates {
+		t, err := template.New(annotation).Option("missingkey=error").Parse(tmpl)
+		if err != nil {
+			return operrors.Wrap(err, fmt.Sprintf("invalid reference annotation template %s", annotation))
+		}
+		parsed[annotation] = t
+	}
+	referenceAnnotationTemplates = parsed
+	return nil
+}
+
+// renderReferenceAnnotations renders the configured annotation templates
+// against the claim. Templates referencing fields the claim does not set are
+// skipped instead of failing the reconcile.
+func renderReferenceAnnotations(projectClaim *gcpv1alpha1.ProjectClaim, annotations map[string]string) {
+	for annotation, tmpl := range referenceAnnotationTemplates {
+		var rendered bytes.Buffer
+		if err := tmpl.Execute(&rendered, projectClaim); err != nil {
+			continue
+		}
+		annotations[annotation] = rendered.String()
+	}
+}
+
+// referenceNameRegexp matches legal DNS-1123 subdomain resource names
+var referenceNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// referenceNameMaxLength is the Kubernetes upper bound on resource names
+const referenceNameMaxLength = 253
+
+// matchingProjectReferenceName returns the name of the ProjectReference
+// serving the claim: the reference-name annotation when it names a legal
+// resource name, otherwise the computed namespace-name.
+func matchingProjectReferenceName(projectClaim *gcpv1alpha1.ProjectClaim) string {
+	if override, ok := projectClaim.GetAnnotations()[ReferenceNameAnnotation]; ok {
+		if len(override) <= referenceNameMaxLength && referenceNameRegexp.MatchString(override) {
+			return override
+		}
+	}
+	return projectClaim.GetNamespace() + "-" + projectClaim.GetName()
+}
+
+// skipDefaultNetworkDefault enables SkipDefaultNetwork for every claim that
+// does not request it itself, for fleets whose security baseline forbids the
+// auto-created default network. Configured via --skip-default-network.
+var skipDefaultNetworkDefault bool
+
+// SetSkipDefaultNetworkDefault sets the operator-wide SkipDefaultNetwork default.
+func SetSkipDefaultNetworkDefault(enabled bool) {
+	skipDefaultNetworkDefault = enabled
+}
+
 func newMatchingProjectReference(projectClaim *gcpv1alpha1.ProjectClaim) *gcpv1alpha1.ProjectReference {
 	gcpProjectID := ""
 	if projectClaim.Spec.CCS {
 		gcpProjectID = projectClaim.Spec.CCSProjectID
 	}
 
+	essentialContacts := make([]gcpv1alpha1.Contact, 0, len(projectClaim.Spec.EssentialContacts))
+	for _, contact := range projectClaim.Spec.EssentialContacts {
+		essentialContacts = append(essentialContacts, *contact.DeepCopy())
+	}
+	if len(essentialContacts) == 0 {
+		essentialContacts = nil
+	}
+
+	var resourceLocations []string
+	if len(projectClaim.Spec.ResourceLocationRestriction) > 0 {
+		resourceLocations = make([]string, len(projectClaim.Spec.ResourceLocationRestriction))
+		copy(resourceLocations, projectClaim.Spec.ResourceLocationRestriction)
+	}
+
+	var trustedImageProjects []string
+	if len(projectClaim.Spec.TrustedImageProjects) > 0 {
+		trustedImageProjects = make([]string, len(projectClaim.Spec.TrustedImageProjects))
+		copy(trustedImageProjects, projectClaim.Spec.TrustedImageProjects)
+	}
+
+	var notificationChannels []gcpv1alpha1.NotificationChannel
+	for _, channel := range projectClaim.Spec.NotificationChannels {
+		notificationChannels = append(notificationChannels, *channel.DeepCopy())
+	}
+
+	var requestedAPIs []string
+	if len(projectClaim.Spec.RequestedAPIs) > 0 {
+		requestedAPIs = make([]string, len(projectClaim.Spec.RequestedAPIs))
+		copy(requestedAPIs, projectClaim.Spec.RequestedAPIs)
+	}
+
+	var labels map[string]string
+	if len(projectClaim.Spec.Labels) > 0 {
+		labels = make(map[string]string, len(projectClaim.Spec.Labels))
+		for key, val := range projectClaim.Spec.Labels {
+			labels[key] = val
+		}
+	}
+
+	var tags map[string]string
+	if len(projectClaim.Spec.Tags) > 0 {
+		tags = make(map[string]string, len(projectClaim.Spec.Tags))
+		for key, val := range projectClaim.Spec.Tags {
+			tags[key] = val
+		}
+	}
+
+	var kmsKeyRings []gcpv1alpha1.KMSKeyRingSpec
+	for _, keyRing := range projectClaim.Spec.KMSKeyRings {
+		kmsKeyRings = append(kmsKeyRings, *keyRing.DeepCopy())
+	}
+	var roleBindings []gcpv1alpha1.RoleBinding
+	for _, roleBinding := range projectClaim.Spec.RoleBindings {
+		roleBindings = append(roleBindings, *roleBinding.DeepCopy())
+	}
+
+	annotations := map[string]string{
+		ClaimUIDAnnotation: string(projectClaim.GetUID()),
+	}
+	renderReferenceAnnotations(projectClaim, annotations)
+
 	return &gcpv1alpha1.ProjectReference{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      projectClaim.GetNamespace() + "-" + projectClaim.GetName(),
-			Namespace: gcpv1alpha1.ProjectReferenceNamespace,
+			Name:        matchingProjectReferenceName(projectClaim),
+			Namespace:   gcpv1alpha1.ProjectReferenceNamespace,
+			Annotations: annotations,
 		},
 		Spec: gcpv1alpha1.ProjectReferenceSpec{
 			GCPProjectID: gcpProjectID,
@@ -90,9 +266,33 @@ func newMatchingProjectReference(projectClaim *gcpv1alpha1.ProjectClaim) *gcpv1a
 				Name:      projectClaim.GetName(),
 				Namespace: projectClaim.GetNamespace(),
 			},
-			LegalEntity:  *projectClaim.Spec.LegalEntity.DeepCopy(),
-			CCS:          projectClaim.Spec.CCS,
-			CCSSecretRef: *projectClaim.Spec.CCSSecretRef.DeepCopy(),
+			LegalEntity:                 *projectClaim.Spec.LegalEntity.DeepCopy(),
+			CCS:                         projectClaim.Spec.CCS,
+			CCSSecretRef:                *projectClaim.Spec.CCSSecretRef.DeepCopy(),
+			EssentialContacts:           essentialContacts,
+			ResourceLocationRestriction: resourceLocations,
+			TrustedImageProjects:        trustedImageProjects,
+			NotificationChannels:        notificationChannels,
+			RequestedAPIs:               requestedAPIs,
+			RequireOSLogin:              projectClaim.Spec.RequireOSLogin,
+			DisableSerialPortAccess:     projectClaim.Spec.DisableSerialPortAccess,
+			SkipCredentialsSecret:       projectClaim.Spec.SkipCredentialsSecret,
+			WorkloadIdentity:            projectClaim.Spec.WorkloadIdentity.DeepCopy(),
+			Parent:                      projectClaim.Spec.Parent.DeepCopy(),
+			DisplayName:                 projectClaim.Spec.DisplayName,
+			Labels:                      labels,
+			Tags:                        tags,
+			CredentialSource:            projectClaim.Spec.CredentialSource.DeepCopy(),
+			ServicePerimeterName:        projectClaim.Spec.ServicePerimeterName,
+			DeleteDefaultNetwork:        projectClaim.Spec.DeleteDefaultNetwork,
+			SkipDefaultNetwork:          projectClaim.Spec.SkipDefaultNetwork || skipDefaultNetworkDefault,
+			Network:                     projectClaim.Spec.Network.DeepCopy(),
+			DefaultComputeRegion:        projectClaim.Spec.DefaultComputeRegion,
+			DefaultComputeZone:          projectClaim.Spec.DefaultComputeZone,
+			RoleBindings:                roleBindings,
+			IAMPolicyMode:               projectClaim.Spec.IAMPolicyMode,
+			SkipBillingManagement:       projectClaim.Spec.SkipBillingManagement,
+			KMSKeyRings:                 kmsKeyRings,
 		},
 	}
 }
@@ -120,6 +320,40 @@ func (adapter *ProjectClaimAdapter) EnsureProjectClaimDeletionProcessed() (gcput
 	return gcputil.ContinueProcessing()
 }
 
+// EnsureNamespaceActive stops the reconcile when the claim's namespace is
+// terminating. Garbage collection is about to delete the claim anyway, so
+// further mutations would only produce confusing errors. Deletion processing
+// runs before this check, so the finalizer is still honoured.
+func (c *ProjectClaimAdapter) EnsureNamespaceActive() (gcputil.OperationResult, error) {
+	namespace := &corev1.Namespace{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.projectClaim.GetNamespace()}, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			c.logger.Info("Namespace of the claim no longer exists. Skipping reconcile")
+			return gcputil.StopProcessing()
+		}
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get namespace %s", c.projectClaim.GetNamespace())))
+	}
+
+	if namespace.Status.Phase != corev1.NamespaceTerminating {
+		return gcputil.ContinueProcessing()
+	}
+
+	c.logger.Info("Namespace of the claim is terminating. Skipping reconcile and leaving cleanup to garbage collection")
+	for _, cond := range c.projectClaim.Status.Conditions {
+		if cond.Type == gcpv1alpha1.ConditionNamespaceTerminating && cond.Status == corev1.ConditionTrue {
+			return gcputil.StopProcessing()
+		}
+	}
+	c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionNamespaceTerminating, corev1.ConditionTrue, "NamespaceTerminating", "the claim's namespace is terminating; the claim is left to garbage collection")
+	if err := c.StatusUpdate(); err != nil {
+		// The claim itself may already be gone; the condition is informational
+		// only, so a failed update must not turn the skip into an error
+		c.logger.V(1).Info("Could not record the NamespaceTerminating condition", "Error", err.Error())
+	}
+	return gcputil.StopProcessing()
+}
+
 func (c *ProjectClaimAdapter) IsProjectClaimDeletion() bool {
 	return c.projectClaim.DeletionTimestamp != nil
 }
@@ -131,8 +365,8 @@ func (c *ProjectClaimAdapter) IsProjectReferenceDeletion() bool {
 func (c *ProjectClaimAdapter) EnsureFinalizerDeleted() error {
 	c.logger.Info("Deleting ProjectClaim Finalizer")
 	finalizers := c.projectClaim.GetFinalizers()
-	if util.Contains(finalizers, ProjectClaimFinalizer) {
-		c.projectClaim.SetFinalizers(util.Filter(finalizers, ProjectClaimFinalizer))
+	if util.Contains(finalizers, projectClaimFinalizerName) {
+		c.projectClaim.SetFinalizers(util.Filter(finalizers, projectClaimFinalizerName))
 		return c.client.Update(context.TODO(), c.projectClaim)
 	}
 	return nil
@@ -154,6 +388,19 @@ func (c *ProjectClaimAdapter) FinalizeProjectClaim() (ObjectState, error) {
 
 	// Assure the finalizer is not deleted as long as ProjectReference exists
 	if !projectReferenceExists {
+		if err := c.deleteCredentialSecretCopy(); err != nil {
+			return ObjectUnchanged, err
+		}
+		// GCP keeps deleted projects in a pending-deletion state for roughly
+		// 30 days. Record the recovery window on the final status update so
+		// consumers watching the deletion know the project can be restored.
+		if c.projectClaim.Spec.GCPProjectID != "" && !c.projectClaim.Spec.CCS {
+			c.projectClaim.Status.Recoverable = true
+			c.projectClaim.Status.RecoveryDeadline = metav1.NewTime(time.Now().Add(projectRecoveryWindow))
+			if err := c.client.Status().Update(context.TODO(), c.projectClaim); err != nil {
+				return ObjectUnchanged, err
+			}
+		}
 		err := c.EnsureFinalizerDeleted()
 		if err != nil {
 			return ObjectUnchanged, err
@@ -167,6 +414,9 @@ func (c *ProjectClaimAdapter) FinalizeProjectClaim() (ObjectState, error) {
 func (c *ProjectClaimAdapter) EnsureProjectClaimInitialized() (gcputil.OperationResult, error) {
 	if c.projectClaim.Status.Conditions == nil {
 		c.projectClaim.Status.Conditions = []gcpv1alpha1.Condition{}
+		if c.projectClaim.Status.Timeline.Created.IsZero() {
+			c.projectClaim.Status.Timeline.Created = metav1.Now()
+		}
 		err := c.client.Status().Update(context.TODO(), c.projectClaim)
 		if err != nil {
 			gcputil.RequeueWithError(operrors.Wrap(err, "failed to initalize projectclaim"))
@@ -176,6 +426,114 @@ func (c *ProjectClaimAdapter) EnsureProjectClaimInitialized() (gcputil.Operation
 	return gcputil.ContinueProcessing()
 }
 
+// annotationConditions maps external annotation keys to the condition type
+// mirrored onto the claim while the annotation is present. Configured via the
+// --claim-annotation-conditions flag; empty by default.
+var annotationConditions = map[string]gcpv1alpha1.ConditionType{}
+
+// SetAnnotationConditions replaces the annotation-to-condition mapping.
+func SetAnnotationConditions(mapping map[string]gcpv1alpha1.ConditionType) {
+	annotationConditions = mapping
+}
+
+// EnsureAnnotationConditions mirrors configured external annotations as
+// conditions on the claim so dashboards can consume them. The annotations are
+// only read, never written; removing an annotation clears its condition.
+func (c *ProjectClaimAdapter) EnsureAnnotationConditions() (gcputil.OperationResult, error) {
+	if len(annotationConditions) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	modified := false
+	annotations := c.projectClaim.GetAnnotations()
+	for key, conditionType := range annotationConditions {
+		value, present := annotations[key]
+		existing, found := c.conditionManager.FindCondition(&c.projectClaim.Status.Conditions, conditionType)
+		if present {
+			if found && existing.Status == corev1.ConditionTrue && existing.Message == value {
+				continue
+			}
+			c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, conditionType, corev1.ConditionTrue, "AnnotationSet", value)
+			modified = true
+		} else if found && existing.Status == corev1.ConditionTrue {
+			c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, conditionType, corev1.ConditionFalse, "AnnotationRemoved", "")
+			modified = true
+		}
+	}
+
+	if modified {
+		if err := c.client.Status().Update(context.TODO(), c.projectClaim); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectClaim status"))
+		}
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// drainAccessRevokeAfter is how long after a claim starts draining its copied
+// credential Secret is deleted, revoking consumer access. Configured via the
+// --drain-access-revoke-after flag; zero keeps the secret in place.
+const defaultDrainAccessRevokeAfter = time.Duration(0)
+
+var drainAccessRevokeAfter = defaultDrainAccessRevokeAfter
+
+// SetDrainAccessRevokeAfter sets the grace period after which a draining
+// claim's credential secret is revoked. Values of zero or below disable
+// revocation.
+func SetDrainAccessRevokeAfter(period time.Duration) {
+	if period <= 0 {
+		drainAccessRevokeAfter = defaultDrainAccessRevokeAfter
+		return
+	}
+	drainAccessRevokeAfter = period
+}
+
+// EnsureDrainProcessed handles claims marked for draining. While Spec.Drain is
+// set the Draining condition tells consumers to stop using the project, and
+// reconciliation stops here so the project configuration stays frozen instead
+// of being kept in sync with the claim. When a revocation grace period is
+// configured, the copied credential Secret is deleted once the claim has been
+// draining that long, cutting off consumers that kept using the key. The
+// project itself is never deleted by a drain; clearing Spec.Drain resumes
+// normal reconciliation.
+func (c *ProjectClaimAdapter) EnsureDrainProcessed() (gcputil.OperationResult, error) {
+	var draining *gcpv1alpha1.Condition
+	for i, cond := range c.projectClaim.Status.Conditions {
+		if cond.Type == gcpv1alpha1.ConditionDraining {
+			draining = &c.projectClaim.Status.Conditions[i]
+			break
+		}
+	}
+
+	if !c.projectClaim.Spec.Drain {
+		if draining != nil && draining.Status == corev1.ConditionTrue {
+			c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionDraining, corev1.ConditionFalse, "DrainLifted", "")
+			return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	if draining == nil || draining.Status != corev1.ConditionTrue {
+		c.logger.Info("Claim is draining, freezing its configuration")
+		c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionDraining, corev1.ConditionTrue, "DrainRequested", "the claim is draining: consumers should stop using the project and its configuration is frozen")
+		return gcputil.RequeueOnErrorOrStop(c.StatusUpdate())
+	}
+
+	if drainAccessRevokeAfter > 0 && draining.Reason != "DrainAccessRevoked" {
+		remaining := drainAccessRevokeAfter - time.Since(draining.LastTransitionTime.Time)
+		if remaining > 0 {
+			return gcputil.RequeueAfter(remaining, nil)
+		}
+		c.logger.Info("Drain grace period expired, revoking the copied credential secret")
+		if err := c.deleteCredentialSecretCopy(); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "could not revoke the credential secret of the draining claim"))
+		}
+		c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionDraining, corev1.ConditionTrue, "DrainAccessRevoked", fmt.Sprintf("the claim has been draining for more than %v; the copied credential secret was revoked", drainAccessRevokeAfter))
+		return gcputil.RequeueOnErrorOrStop(c.StatusUpdate())
+	}
+
+	return gcputil.StopProcessing()
+}
+
 func (c *ProjectClaimAdapter) EnsureProjectReferenceLink() (gcputil.OperationResult, error) {
 	expectedLink := gcpv1alpha1.NamespacedName{
 		Name:      c.projectReference.GetName(),
@@ -192,10 +550,71 @@ func (c *ProjectClaimAdapter) EnsureProjectReferenceLink() (gcputil.OperationRes
 	return gcputil.StopProcessing()
 }
 
+// EnsureLinkConsistency cross-checks that the claim's ProjectReferenceCRLink
+// and the reference's ProjectClaimCRLink still agree after manual edits. A link
+// to a missing reference and a back-link to a missing claim are repaired; a
+// back-link to a different existing claim is ambiguous, as rewriting either
+// side could steal a project, so it is only surfaced via the LinkInconsistent
+// condition.
+func (c *ProjectClaimAdapter) EnsureLinkConsistency() (gcputil.OperationResult, error) {
+	link := c.projectClaim.Spec.ProjectReferenceCRLink
+	if link == (gcpv1alpha1.NamespacedName{}) {
+		return gcputil.ContinueProcessing()
+	}
+
+	found := &gcpv1alpha1.ProjectReference{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: link.Name, Namespace: link.Namespace}, found)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return gcputil.RequeueWithError(err)
+		}
+		// The claim points at a reference that is gone; re-point it at the
+		// generated reference so the next pass can recreate it.
+		c.logger.Info("ProjectReferenceCRLink points at a missing ProjectReference, repairing the link")
+		c.projectClaim.Spec.ProjectReferenceCRLink = gcpv1alpha1.NamespacedName{
+			Name:      c.projectReference.GetName(),
+			Namespace: c.projectReference.GetNamespace(),
+		}
+		return gcputil.RequeueOnErrorOrStop(c.client.Update(context.TODO(), c.projectClaim))
+	}
+
+	backLink := found.Spec.ProjectClaimCRLink
+	expectedBackLink := gcpv1alpha1.NamespacedName{
+		Name:      c.projectClaim.GetName(),
+		Namespace: c.projectClaim.GetNamespace(),
+	}
+	conditions := &c.projectClaim.Status.Conditions
+	if backLink == expectedBackLink {
+		// Only mark the condition resolved if an earlier pass reported an inconsistency
+		if existing, ok := c.conditionManager.FindCondition(conditions, gcpv1alpha1.ConditionLinkInconsistent); ok && existing.Status == corev1.ConditionTrue {
+			c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionLinkInconsistent, corev1.ConditionFalse, "LinkConsistent", "the claim and its ProjectReference link to each other again")
+			return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	otherClaim := &gcpv1alpha1.ProjectClaim{}
+	err = c.client.Get(context.TODO(), types.NamespacedName{Name: backLink.Name, Namespace: backLink.Namespace}, otherClaim)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return gcputil.RequeueWithError(err)
+		}
+		// The back-link names a claim that does not exist, so repairing it
+		// cannot take the reference away from anyone.
+		c.logger.Info("ProjectReference links back to a missing claim, repairing the back-link")
+		found.Spec.ProjectClaimCRLink = expectedBackLink
+		return gcputil.RequeueOnErrorOrStop(c.client.Update(context.TODO(), found))
+	}
+
+	c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionLinkInconsistent, corev1.ConditionTrue, "LinkInconsistent", fmt.Sprintf("ProjectReference %s/%s links back to claim %s/%s, not this claim", link.Namespace, link.Name, backLink.Namespace, backLink.Name))
+	c.StatusUpdate()
+	return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrMismatchedProjectReference, fmt.Sprintf("ProjectReference %s/%s links back to a different existing claim", link.Namespace, link.Name)))
+}
+
 func (c *ProjectClaimAdapter) EnsureFinalizer() (gcputil.OperationResult, error) {
-	if !util.Contains(c.projectClaim.GetFinalizers(), ProjectClaimFinalizer) {
+	if !util.Contains(c.projectClaim.GetFinalizers(), projectClaimFinalizerName) {
 		c.logger.Info("Adding Finalizer to the ProjectClaim")
-		c.projectClaim.SetFinalizers(append(c.projectClaim.GetFinalizers(), ProjectClaimFinalizer))
+		c.projectClaim.SetFinalizers(append(c.projectClaim.GetFinalizers(), projectClaimFinalizerName))
 
 		err := c.client.Update(context.TODO(), c.projectClaim)
 		if err != nil {
@@ -206,18 +625,307 @@ func (c *ProjectClaimAdapter) EnsureFinalizer() (gcputil.OperationResult, error)
 	return gcputil.ContinueProcessing()
 }
 
-func (c *ProjectClaimAdapter) EnsureProjectReferenceExists() (gcputil.OperationResult, error) {
-	projectReferenceExists, err := c.ProjectReferenceExists()
+// EnsureLegalEntityBillingApplied follows central remaps of a legal entity's billing
+// account. When the operator ConfigMap resolves a different billing account for the
+// claim's legal entity than the one currently requested, the claim is re-pointed at
+// the resolved account and a BillingRelinked condition records the change. The
+// actual re-link in GCP is performed by the ProjectReference controller, which
+// compares the claim's billing account against the one linked to the project.
+// Once the claim matches the central mapping this is a no-op, so a single remap
+// cannot flap the billing link back and forth.
+func (c *ProjectClaimAdapter) EnsureLegalEntityBillingApplied() (gcputil.OperationResult, error) {
+	if c.projectClaim.Spec.CCS || c.projectClaim.Spec.LegalEntity.ID == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	resolved, found := configmap.LegalEntityBillingAccount(c.client, c.projectClaim.Spec.LegalEntity.ID)
+	if !found || resolved == c.projectClaim.Spec.BillingAccountID {
+		return gcputil.ContinueProcessing()
+	}
+
+	c.logger.Info("Billing account of the claim's legal entity was remapped centrally, re-linking", "LegalEntity", c.projectClaim.Spec.LegalEntity.ID, "BillingAccount", resolved)
+	c.projectClaim.Spec.BillingAccountID = resolved
+	if err := c.client.Update(context.TODO(), c.projectClaim); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error applying the centrally resolved billing account to the ProjectClaim"))
+	}
+
+	c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionBillingRelinked, corev1.ConditionTrue, "BillingRelinked", fmt.Sprintf("billing account re-linked to %s after a central change for legal entity %s", resolved, c.projectClaim.Spec.LegalEntity.ID))
+	return gcputil.RequeueOnErrorOrStop(c.StatusUpdate())
+}
+
+// EnsureTemplateApplied merges the provisioning defaults of the ProjectReference
+// template referenced by the claim's templateRef into the generated reference
+// spec. Claim-level fields take precedence over template fields, so a template
+// can only fill in what the claim leaves unset. A missing template halts
+// provisioning and is surfaced via the TemplateFound condition.
+func (c *ProjectClaimAdapter) EnsureTemplateApplied() (gcputil.OperationResult, error) {
+	if c.projectClaim.Spec.TemplateRef == nil {
+		return gcputil.ContinueProcessing()
+	}
+
+	conditions := &c.projectClaim.Status.Conditions
+	template := &gcpv1alpha1.ProjectReference{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.projectClaim.Spec.TemplateRef.Name, Namespace: c.projectClaim.Spec.TemplateRef.Namespace}, template)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return gcputil.RequeueWithError(err)
+		}
+		c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionTemplateFound, corev1.ConditionFalse, "TemplateMissing", fmt.Sprintf("ProjectReference template %s/%s does not exist", c.projectClaim.Spec.TemplateRef.Namespace, c.projectClaim.Spec.TemplateRef.Name))
+		c.StatusUpdate()
+		return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrTemplateMissing, fmt.Sprintf("ProjectReference template %s/%s does not exist", c.projectClaim.Spec.TemplateRef.Namespace, c.projectClaim.Spec.TemplateRef.Name)))
+	}
+
+	mergeTemplateDefaults(&c.projectReference.Spec, template.Spec)
+
+	// Only mark the condition resolved if an earlier pass reported the template missing
+	if existing, found := c.conditionManager.FindCondition(conditions, gcpv1alpha1.ConditionTemplateFound); found && existing.Status == corev1.ConditionFalse {
+		c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionTemplateFound, corev1.ConditionTrue, "TemplateFound", fmt.Sprintf("ProjectReference template %s/%s found", c.projectClaim.Spec.TemplateRef.Namespace, c.projectClaim.Spec.TemplateRef.Name))
+		return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// mergeTemplateDefaults fills template-provided defaults into the desired
+// reference spec without overriding anything derived from the claim: list and
+// map entries are added only when absent, boolean org policies are OR-ed since
+// an unset claim field is indistinguishable from an explicit false.
+func mergeTemplateDefaults(desired *gcpv1alpha1.ProjectReferenceSpec, template gcpv1alpha1.ProjectReferenceSpec) {
+	for _, api := range template.RequestedAPIs {
+		if !util.Contains(desired.RequestedAPIs, api) {
+			desired.RequestedAPIs = append(desired.RequestedAPIs, api)
+		}
+	}
+
+	if len(template.Labels) > 0 && desired.Labels == nil {
+		desired.Labels = map[string]string{}
+	}
+	for key, value := range template.Labels {
+		if _, ok := desired.Labels[key]; !ok {
+			desired.Labels[key] = value
+		}
+	}
+
+	desired.RequireOSLogin = desired.RequireOSLogin || template.RequireOSLogin
+	desired.DisableSerialPortAccess = desired.DisableSerialPortAccess || template.DisableSerialPortAccess
+	desired.SkipDefaultNetwork = desired.SkipDefaultNetwork || template.SkipDefaultNetwork
+	desired.DeleteDefaultNetwork = desired.DeleteDefaultNetwork || template.DeleteDefaultNetwork
+	if len(desired.ResourceLocationRestriction) == 0 && len(template.ResourceLocationRestriction) > 0 {
+		desired.ResourceLocationRestriction = make([]string, len(template.ResourceLocationRestriction))
+		copy(desired.ResourceLocationRestriction, template.ResourceLocationRestriction)
+	}
+	if len(desired.TrustedImageProjects) == 0 && len(template.TrustedImageProjects) > 0 {
+		desired.TrustedImageProjects = make([]string, len(template.TrustedImageProjects))
+		copy(desired.TrustedImageProjects, template.TrustedImageProjects)
+	}
+}
+
+// ReasonProjectLimitReached is the condition reason set when the claim's
+// legal entity already has the configured maximum number of projects.
+const ReasonProjectLimitReached = "ProjectLimitReached"
+
+// maxProjectsPerLegalEntity caps how many ProjectReferences a single legal
+// entity may have; 0 disables the cap. Configured via
+// --max-projects-per-legal-entity.
+var maxProjectsPerLegalEntity int
+
+// SetMaxProjectsPerLegalEntity sets the maximum number of ProjectReferences a
+// legal entity may have. Values below one disable the cap.
+func SetMaxProjectsPerLegalEntity(limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+	maxProjectsPerLegalEntity = limit
+}
+
+// EnsureProjectLimitNotReached blocks provisioning a new project when the
+// claim's legal entity already has the configured maximum number of
+// ProjectReferences, to cap spend. Claims whose reference already exists are
+// not affected: the cap only stops new projects, it never tears down
+// provisioned ones.
+func (c *ProjectClaimAdapter) EnsureProjectLimitNotReached() (gcputil.OperationResult, error) {
+	if maxProjectsPerLegalEntity == 0 || c.projectClaim.Spec.LegalEntity.ID == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	exists, err := c.ProjectReferenceExists()
 	if err != nil {
 		return gcputil.RequeueWithError(err)
 	}
+	if exists {
+		return gcputil.ContinueProcessing()
+	}
 
-	if !projectReferenceExists {
-		return gcputil.RequeueOnErrorOrContinue(c.client.Create(context.TODO(), c.projectReference))
+	referenceList := &gcpv1alpha1.ProjectReferenceList{}
+	if err := c.client.List(context.TODO(), &client.ListOptions{}, referenceList); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "could not list ProjectReferences to enforce the project limit"))
+	}
+
+	count := 0
+	for _, reference := range referenceList.Items {
+		if reference.Spec.LegalEntity.ID == c.projectClaim.Spec.LegalEntity.ID {
+			count++
+		}
+	}
+	if count < maxProjectsPerLegalEntity {
+		return gcputil.ContinueProcessing()
+	}
+
+	c.logger.Info("Legal entity reached its project limit, blocking provisioning", "LegalEntity", c.projectClaim.Spec.LegalEntity.ID, "Projects", count, "Limit", maxProjectsPerLegalEntity)
+	return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrProjectLimitReached, fmt.Sprintf("legal entity %s already has %d of at most %d projects", c.projectClaim.Spec.LegalEntity.ID, count, maxProjectsPerLegalEntity)))
+}
+
+func (c *ProjectClaimAdapter) EnsureProjectReferenceExists() (gcputil.OperationResult, error) {
+	found := &gcpv1alpha1.ProjectReference{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.projectReference.Name, Namespace: c.projectReference.Namespace}, found)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return gcputil.RequeueWithError(err)
+		}
+		// Creating the reference kicks off a chain of GCP API calls; while the
+		// error budget is exhausted, delay new provisioning until the rate recovers.
+		if errorbudget.Exhausted() {
+			c.logger.Info("GCP error budget exhausted, delaying ProjectReference creation")
+			return gcputil.RequeueAfter(errorbudget.BackpressureDelay, nil)
+		}
+		if err := c.client.Create(context.TODO(), c.projectReference); err != nil {
+			if errors.IsNotFound(err) {
+				return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrReferenceNamespaceMissing, err.Error()))
+			}
+			return gcputil.RequeueWithError(err)
+		}
+		// The timeline milestone only records the first creation; a reference
+		// recreated after an out-of-band deletion leaves it untouched.
+		firstCreation := c.projectClaim.Status.Timeline.ReferenceCreated.IsZero()
+		if firstCreation {
+			c.projectClaim.Status.Timeline.ReferenceCreated = metav1.Now()
+		}
+		// A missing reference for a claim that already reached Ready was deleted
+		// out-of-band. Recreating it alone is not enough: the reference controller
+		// only provisions while the claim is PendingProject, so step the claim
+		// back to let the project be restored.
+		if c.projectClaim.Status.State == gcpv1alpha1.ClaimStatusReady {
+			c.logger.Info("ProjectReference was deleted out-of-band, recreating it and reverting the claim to PendingProject")
+			if err := c.appendStateHistory(gcpv1alpha1.ClaimStatusPendingProject); err != nil {
+				return gcputil.RequeueWithError(err)
+			}
+			c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusPendingProject
+			return gcputil.RequeueOnErrorOrStop(c.StatusUpdate())
+		}
+		if firstCreation {
+			return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	// A UID mismatch means the claim was deleted and recreated under the same
+	// name. The reference belongs to the previous incarnation and must not be
+	// reused; deleting it lets the next reconcile reprovision from scratch.
+	recordedUID := found.GetAnnotations()[ClaimUIDAnnotation]
+	if recordedUID != "" && recordedUID != string(c.projectClaim.GetUID()) {
+		c.logger.Info("ProjectReference was created for a previous incarnation of the claim, deleting it", "recordedUID", recordedUID)
+		if err := c.client.Delete(context.TODO(), found); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "error deleting the stale ProjectReference"))
+		}
+		return gcputil.RequeueAfter(5*time.Second, nil)
+	}
+
+	// A reference without a recorded claim UID was created out-of-band, so name
+	// collision is the only thing that got us here. Only adopt it when it points
+	// back at this claim and belongs to the same legal entity; reusing a project
+	// provisioned for someone else must be an explicit error, not a silent adoption.
+	if recordedUID == "" {
+		if found.Spec.ProjectClaimCRLink.Name != c.projectClaim.GetName() ||
+			found.Spec.ProjectClaimCRLink.Namespace != c.projectClaim.GetNamespace() ||
+			found.Spec.LegalEntity.ID != c.projectClaim.Spec.LegalEntity.ID {
+			c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+			c.StatusUpdate()
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrMismatchedProjectReference, fmt.Sprintf("existing ProjectReference %s does not match the claim's CR link or legal entity and cannot be adopted", found.Name)))
+		}
 	}
 	return gcputil.ContinueProcessing()
 }
 
+// EnsureProjectReferenceSpecApplied compares the ProjectReference spec derived from the claim with
+// the one on the cluster. Instead of applying a change directly, the diff is published in the
+// claim's pending-diff annotation and only applied once the approve annotation is set.
+// The desired spec is rebuilt from the claim on every reconcile, including empty values,
+// so clearing an optional claim field also clears the propagated reference field.
+func (c *ProjectClaimAdapter) EnsureProjectReferenceSpecApplied() (gcputil.OperationResult, error) {
+	found := &gcpv1alpha1.ProjectReference{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.projectReference.Name, Namespace: c.projectReference.Namespace}, found)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return gcputil.ContinueProcessing()
+		}
+		return gcputil.RequeueWithError(err)
+	}
+
+	desiredSpec := *c.projectReference.Spec.DeepCopy()
+	guardImmutableSpecFields(&desiredSpec, found.Spec)
+
+	if reflect.DeepEqual(desiredSpec, found.Spec) {
+		return gcputil.RequeueOnErrorOrContinue(c.clearPendingDiff())
+	}
+
+	if c.projectClaim.GetAnnotations()[ApproveAnnotation] != "true" {
+		diff := formatPendingDiff(found.Spec, desiredSpec)
+		if c.projectClaim.GetAnnotations()[PendingDiffAnnotation] == diff {
+			return gcputil.StopProcessing()
+		}
+		c.logger.Info("Publishing pending ProjectReference spec diff on the ProjectClaim")
+		annotations := c.projectClaim.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[PendingDiffAnnotation] = diff
+		c.projectClaim.SetAnnotations(annotations)
+		return gcputil.RequeueOnErrorOrStop(c.client.Update(context.TODO(), c.projectClaim))
+	}
+
+	c.logger.Info("Applying approved ProjectReference spec diff")
+	found.Spec = desiredSpec
+	if err := c.client.Update(context.TODO(), found); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error applying approved ProjectReference spec"))
+	}
+	return gcputil.RequeueOnErrorOrStop(c.clearPendingDiff())
+}
+
+// guardImmutableSpecFields keeps reference fields that cannot be changed once acted
+// upon in GCP. All other fields follow the claim, including removals.
+func guardImmutableSpecFields(desired *gcpv1alpha1.ProjectReferenceSpec, current gcpv1alpha1.ProjectReferenceSpec) {
+	// The GCPProjectID is owned by the ProjectReference controller for non-CCS projects
+	// and must not show up in the diff
+	if desired.GCPProjectID == "" {
+		desired.GCPProjectID = current.GCPProjectID
+	}
+	// Whether a project is customer-supplied is decided at creation time; a
+	// provisioned project cannot be turned into a CCS one or vice versa
+	desired.CCS = current.CCS
+}
+
+// clearPendingDiff removes the pending-diff and approve annotations from the claim if present
+func (c *ProjectClaimAdapter) clearPendingDiff() error {
+	annotations := c.projectClaim.GetAnnotations()
+	_, diffSet := annotations[PendingDiffAnnotation]
+	_, approveSet := annotations[ApproveAnnotation]
+	if !diffSet && !approveSet {
+		return nil
+	}
+	delete(annotations, PendingDiffAnnotation)
+	delete(annotations, ApproveAnnotation)
+	c.projectClaim.SetAnnotations(annotations)
+	return c.client.Update(context.TODO(), c.projectClaim)
+}
+
+// formatPendingDiff renders the spec change in a human readable way, truncated to fit into an annotation
+func formatPendingDiff(current, desired gcpv1alpha1.ProjectReferenceSpec) string {
+	diff := fmt.Sprintf("current: %+v desired: %+v", current, desired)
+	if len(diff) > pendingDiffMaxLength {
+		diff = diff[:pendingDiffMaxLength]
+	}
+	return diff
+}
+
 func (c *ProjectClaimAdapter) EnsureProjectClaimStatePending() (gcputil.OperationResult, error) {
 	return c.EnsureProjectClaimState(gcpv1alpha1.ClaimStatusPending)
 }
@@ -243,6 +951,10 @@ func (c *ProjectClaimAdapter) EnsureProjectClaimState(state gcpv1alpha1.ClaimSta
 		}
 	}
 
+	if err := c.appendStateHistory(state); err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+
 	c.projectClaim.Status.State = state
 	err := c.StatusUpdate()
 	if err != nil {
@@ -252,12 +964,314 @@ func (c *ProjectClaimAdapter) EnsureProjectClaimState(state gcpv1alpha1.ClaimSta
 	return gcputil.StopProcessing()
 }
 
+// dependenciesRequeuePeriod is how often a claim waiting for its dependsOn
+// claims re-checks their state
+const dependenciesRequeuePeriod = 30 * time.Second
+
+// EnsureDependenciesReady holds the claim at the WaitingForDependencies
+// condition until every claim listed in spec.dependsOn is Ready, so hub
+// projects can be provisioned before their spokes. DependsOn references that
+// form a cycle can never all become Ready and are rejected instead of waiting
+// forever.
+func (c *ProjectClaimAdapter) EnsureDependenciesReady() (gcputil.OperationResult, error) {
+	if len(c.projectClaim.Spec.DependsOn) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	conditions := &c.projectClaim.Status.Conditions
+
+	notReady := []string{}
+	for _, dep := range c.projectClaim.Spec.DependsOn {
+		claim := &gcpv1alpha1.ProjectClaim{}
+		err := c.client.Get(context.TODO(), types.NamespacedName{Name: dep.Name, Namespace: dep.Namespace}, claim)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get dependency claim %s/%s", dep.Namespace, dep.Name)))
+			}
+			notReady = append(notReady, fmt.Sprintf("%s/%s (not found)", dep.Namespace, dep.Name))
+			continue
+		}
+		if claim.Status.State != gcpv1alpha1.ClaimStatusReady {
+			notReady = append(notReady, fmt.Sprintf("%s/%s", dep.Namespace, dep.Name))
+		}
+	}
+
+	if len(notReady) == 0 {
+		for _, cond := range c.projectClaim.Status.Conditions {
+			if cond.Type == gcpv1alpha1.ConditionWaitingForDependencies && cond.Status == corev1.ConditionTrue {
+				c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionFalse, "DependenciesReady", "")
+				return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+			}
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	cycle, err := c.findDependencyCycle()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	if len(cycle) > 0 {
+		message := fmt.Sprintf("dependsOn references form a cycle: %s", strings.Join(cycle, " -> "))
+		c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionTrue, "DependencyCycle", message)
+		if err := c.StatusUpdate(); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+		return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrDependencyCycle, message))
+	}
+
+	c.logger.V(1).Info("Waiting for dependency claims to become Ready", "NotReady", strings.Join(notReady, ", "))
+	c.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionTrue, "WaitingForDependencies", fmt.Sprintf("waiting for dependency claims to become Ready: %s", strings.Join(notReady, ", ")))
+	if err := c.StatusUpdate(); err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.RequeueAfter(dependenciesRequeuePeriod, nil)
+}
+
+// findDependencyCycle walks the dependsOn graph starting from this claim and
+// returns the first cycle found as namespace/name keys, with the repeated
+// claim at both ends. Claims that do not exist cannot close a cycle and are
+// treated as leaves.
+func (c *ProjectClaimAdapter) findDependencyCycle() ([]string, error) {
+	visited := map[string]bool{}
+
+	var walk func(deps []gcpv1alpha1.NamespacedName, path []string) ([]string, error)
+	walk = func(deps []gcpv1alpha1.NamespacedName, path []string) ([]string, error) {
+		for _, dep := range deps {
+			depKey := fmt.Sprintf("%s/%s", dep.Namespace, dep.Name)
+			for i := range path {
+				if path[i] == depKey {
+					return append(path[i:], depKey), nil
+				}
+			}
+			if visited[depKey] {
+				continue
+			}
+			visited[depKey] = true
+
+			claim := &gcpv1alpha1.ProjectClaim{}
+			err := c.client.Get(context.TODO(), types.NamespacedName{Name: dep.Name, Namespace: dep.Namespace}, claim)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, operrors.Wrap(err, fmt.Sprintf("could not get dependency claim %s", depKey))
+			}
+
+			cycle, err := walk(claim.Spec.DependsOn, append(path, depKey))
+			if err != nil || len(cycle) > 0 {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	start := fmt.Sprintf("%s/%s", c.projectClaim.GetNamespace(), c.projectClaim.GetName())
+	visited[start] = true
+	return walk(c.projectClaim.Spec.DependsOn, []string{start})
+}
+
+// appendStateHistory records a state transition in the state-history annotation,
+// dropping the oldest entries once stateHistoryMaxEntries is exceeded
+func (c *ProjectClaimAdapter) appendStateHistory(state gcpv1alpha1.ClaimStatus) error {
+	annotations := c.projectClaim.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	var entries []string
+	if history := annotations[StateHistoryAnnotation]; history != "" {
+		entries = strings.Split(history, ",")
+	}
+	entries = append(entries, fmt.Sprintf("%s@%s", state, time.Now().UTC().Format(time.RFC3339)))
+	if len(entries) > stateHistoryMaxEntries {
+		entries = entries[len(entries)-stateHistoryMaxEntries:]
+	}
+
+	annotations[StateHistoryAnnotation] = strings.Join(entries, ",")
+	c.projectClaim.SetAnnotations(annotations)
+	return c.client.Update(context.TODO(), c.projectClaim)
+}
+
+// credentialCopySecretName returns the name of the copied credential secret, derived from the claim
+func (c *ProjectClaimAdapter) credentialCopySecretName() string {
+	return c.projectClaim.GetName() + "-gcp-credentials"
+}
+
+// EnsureCredentialSecretPresent restores the copied credential Secret when it was
+// deleted after the claim became Ready, and surfaces the recovery through the
+// CredentialSecretRecreated condition. If the provisioned source Secret is gone
+// too, the ProjectReference controller re-mints a service account key on its
+// next reconcile, so the claim requeues until the source reappears.
+func (c *ProjectClaimAdapter) EnsureCredentialSecretPresent() (gcputil.OperationResult, error) {
+	if c.projectClaim.Spec.SkipCredentialsSecret || c.projectClaim.Status.State != gcpv1alpha1.ClaimStatusReady {
+		return gcputil.ContinueProcessing()
+	}
+
+	target := &corev1.Secret{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.credentialCopySecretName(), Namespace: c.projectClaim.GetNamespace()}, target)
+	if err == nil {
+		return gcputil.ContinueProcessing()
+	}
+	if !errors.IsNotFound(err) {
+		return gcputil.RequeueWithError(err)
+	}
+
+	if _, err := gcputil.GetSecret(c.client, c.projectClaim.Spec.GCPCredentialSecret.Name, c.projectClaim.Spec.GCPCredentialSecret.Namespace); err != nil {
+		if errors.IsNotFound(err) {
+			c.logger.Info("Credential secret and its source are both gone, waiting for the key to be re-minted")
+			return gcputil.RequeueAfter(30*time.Second, nil)
+		}
+		return gcputil.RequeueWithError(err)
+	}
+
+	c.logger.Info(fmt.Sprintf("Restoring deleted credential secret %s", c.credentialCopySecretName()))
+	if result, err := c.EnsureCredentialSecretCopied(); err != nil {
+		return result, err
+	}
+	c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionCredentialSecretRecreated, corev1.ConditionTrue, "CredentialSecretRecreated", fmt.Sprintf("the credential secret %s was deleted and has been recreated", c.credentialCopySecretName()))
+	return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+}
+
+// EnsureCredentialSecretCopied copies the provisioned credential Secret into the claim's
+// namespace under a name derived from the claim, owned by the claim so it is garbage
+// collected with it. The copy is kept in sync if the source Secret rotates.
+func (c *ProjectClaimAdapter) EnsureCredentialSecretCopied() (gcputil.OperationResult, error) {
+	if c.projectClaim.Spec.SkipCredentialsSecret {
+		return c.ensureLeftoverCredentialSecretsRemoved()
+	}
+
+	source, err := gcputil.GetSecret(c.client, c.projectClaim.Spec.GCPCredentialSecret.Name, c.projectClaim.Spec.GCPCredentialSecret.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// The credentials have not been provisioned yet
+			return gcputil.ContinueProcessing()
+		}
+		return gcputil.RequeueWithError(err)
+	}
+
+	// The source secret already is the copy we would create
+	if source.GetName() == c.credentialCopySecretName() && source.GetNamespace() == c.projectClaim.GetNamespace() {
+		return gcputil.ContinueProcessing()
+	}
+
+	target := &corev1.Secret{}
+	err = c.client.Get(context.TODO(), types.NamespacedName{Name: c.credentialCopySecretName(), Namespace: c.projectClaim.GetNamespace()}, target)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return gcputil.RequeueWithError(err)
+		}
+
+		c.logger.V(1).Info(fmt.Sprintf("Copying credential secret %s into namespace %s", c.credentialCopySecretName(), c.projectClaim.GetNamespace()))
+		controller := true
+		copiedSecret := &corev1.Secret{
+			Type: source.Type,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      c.credentialCopySecretName(),
+				Namespace: c.projectClaim.GetNamespace(),
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: gcpv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "ProjectClaim",
+					Name:       c.projectClaim.GetName(),
+					UID:        c.projectClaim.GetUID(),
+					Controller: &controller,
+				}},
+			},
+			Data: source.Data,
+		}
+		if err := c.client.Create(context.TODO(), copiedSecret); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not copy the credential secret to %s", c.credentialCopySecretName())))
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	if !reflect.DeepEqual(target.Data, source.Data) {
+		c.logger.V(1).Info(fmt.Sprintf("Syncing rotated credential secret to %s", c.credentialCopySecretName()))
+		target.Data = source.Data
+		if err := c.client.Update(context.TODO(), target); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not sync the credential secret to %s", c.credentialCopySecretName())))
+		}
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// ensureLeftoverCredentialSecretsRemoved cleans up credential Secrets left
+// behind when a claim stops wanting one: the copy in the claim namespace and
+// the provisioned source Secret. Only Secrets written by the operator are
+// deleted; the copy must be owned by this claim and the source must carry the
+// managed-by label. The removal is surfaced through the
+// CredentialSecretRemoved condition.
+func (c *ProjectClaimAdapter) ensureLeftoverCredentialSecretsRemoved() (gcputil.OperationResult, error) {
+	removed := []string{}
+
+	target := &corev1.Secret{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.credentialCopySecretName(), Namespace: c.projectClaim.GetNamespace()}, target)
+	if err != nil && !errors.IsNotFound(err) {
+		return gcputil.RequeueWithError(err)
+	}
+	if err == nil && c.ownsSecret(target) {
+		c.logger.Info(fmt.Sprintf("Deleting no longer wanted credential secret %s", target.GetName()))
+		if err := c.client.Delete(context.TODO(), target); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+		removed = append(removed, fmt.Sprintf("%s/%s", target.GetNamespace(), target.GetName()))
+	}
+
+	source, err := gcputil.GetSecret(c.client, c.projectClaim.Spec.GCPCredentialSecret.Name, c.projectClaim.Spec.GCPCredentialSecret.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return gcputil.RequeueWithError(err)
+	}
+	sameAsCopy := err == nil && source.GetName() == c.credentialCopySecretName() && source.GetNamespace() == c.projectClaim.GetNamespace()
+	if err == nil && !sameAsCopy && source.GetLabels()[gcputil.ManagedByLabel] == gcputil.ManagedByValue {
+		c.logger.Info(fmt.Sprintf("Deleting no longer wanted credential secret %s", source.GetName()))
+		if err := c.client.Delete(context.TODO(), source); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+		removed = append(removed, fmt.Sprintf("%s/%s", source.GetNamespace(), source.GetName()))
+	}
+
+	if len(removed) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+	c.conditionManager.SetCondition(&c.projectClaim.Status.Conditions, gcpv1alpha1.ConditionCredentialSecretRemoved, corev1.ConditionTrue, "CredentialSecretRemoved", fmt.Sprintf("credential secrets no longer wanted by the claim were deleted: %s", strings.Join(removed, ", ")))
+	return gcputil.RequeueOnErrorOrContinue(c.StatusUpdate())
+}
+
+// ownsSecret reports whether the Secret was written by the operator for this
+// claim, either through an owner reference back to the claim or the
+// managed-by label.
+func (c *ProjectClaimAdapter) ownsSecret(secret *corev1.Secret) bool {
+	if secret.GetLabels()[gcputil.ManagedByLabel] == gcputil.ManagedByValue {
+		return true
+	}
+	for _, ref := range secret.GetOwnerReferences() {
+		if ref.Kind == "ProjectClaim" && ref.Name == c.projectClaim.GetName() && ref.UID == c.projectClaim.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteCredentialSecretCopy removes the copied credential secret during finalization
+func (c *ProjectClaimAdapter) deleteCredentialSecretCopy() error {
+	target := &corev1.Secret{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: c.credentialCopySecretName(), Namespace: c.projectClaim.GetNamespace()}, target)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return c.client.Delete(context.TODO(), target)
+}
+
 // SetProjectClaimCondition calls SetCondition() with project claim conditions
+// and stamps the condition with the generation of the claim it reflects
 func (c *ProjectClaimAdapter) SetProjectClaimCondition(reason string, err error) error {
 	conditions := &c.projectClaim.Status.Conditions
 	conditionType := gcpv1alpha1.ConditionError
 	if err != nil {
-		c.conditionManager.SetCondition(conditions, conditionType, corev1.ConditionTrue, reason, err.Error())
+		c.conditionManager.SetCondition(conditions, conditionType, corev1.ConditionTrue, reason, condition.TruncateMessage(err.Error()))
+		c.projectClaim.Status.RecentErrors = condition.AppendRecentError(c.projectClaim.Status.RecentErrors, reason, err, condition.RecentErrorsMaxEntries)
 	} else {
 		if len(*conditions) != 0 {
 			reason = reason + "Resolved"
@@ -266,14 +1280,54 @@ func (c *ProjectClaimAdapter) SetProjectClaimCondition(reason string, err error)
 			return nil
 		}
 	}
+	c.stampObservedGeneration(conditionType)
 
 	return c.StatusUpdate()
 }
 
-// IsRegionSupported checks if current region is supported.
+// operatorIdentity identifies this operator instance in claim statuses, set
+// from the environment at startup. Empty when no identity is configured.
+var operatorIdentity string
+
+// SetOperatorIdentity sets the identity recorded in
+// ProjectClaimStatus.ReconciledBy after successful reconciles.
+func SetOperatorIdentity(identity string) {
+	operatorIdentity = identity
+}
+
+// SetLastReconcileTime records on the claim status when it was last reconciled
+// without an error, so monitoring can alert on claims that have gone stale.
+// It is only called after successful reconciles to avoid masking failures.
+// When an operator identity is configured, the reconciling instance is
+// recorded alongside, for debugging split ownership in HA deployments.
+func (c *ProjectClaimAdapter) SetLastReconcileTime() error {
+	c.projectClaim.Status.LastReconcileTime = metav1.Now()
+	if operatorIdentity != "" {
+		c.projectClaim.Status.ReconciledBy = operatorIdentity
+	}
+	return c.StatusUpdate()
+}
+
+// stampObservedGeneration records on the condition which claim generation it
+// was computed from, so tooling can ignore conditions from stale generations
+func (c *ProjectClaimAdapter) stampObservedGeneration(conditionType gcpv1alpha1.ConditionType) {
+	conditions := c.projectClaim.Status.Conditions
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			conditions[i].ObservedGeneration = c.projectClaim.Generation
+		}
+	}
+}
+
+// IsRegionSupported checks if every requested region is supported.
 // It returns an error message if a region is not supported.
 func (c *ProjectClaimAdapter) IsRegionSupported() error {
-	if _, ok := supportedRegions[c.projectClaim.Spec.Region]; !ok {
+	for _, region := range c.projectClaim.Spec.EffectiveRegions() {
+		if _, ok := supportedRegions[region]; !ok {
+			return operrors.ErrRegionNotSupported
+		}
+	}
+	if len(c.projectClaim.Spec.EffectiveRegions()) == 0 {
 		return operrors.ErrRegionNotSupported
 	}
 	return nil
@@ -290,9 +1344,229 @@ func (c *ProjectClaimAdapter) EnsureRegionSupported() (gcputil.OperationResult,
 	return gcputil.ContinueProcessing()
 }
 
-// StatusUpdate updates the project claim status
+// parentIDRegexp matches the numeric IDs GCP assigns to folders and organizations
+var parentIDRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// IsParentValid checks that the requested parent, if any, names exactly one
+// supported parent type and carries a well-formed numeric ID.
+func (c *ProjectClaimAdapter) IsParentValid() error {
+	parent := c.projectClaim.Spec.Parent
+	if parent == nil {
+		return nil
+	}
+	if parent.Type != gcpv1alpha1.ParentTypeFolder && parent.Type != gcpv1alpha1.ParentTypeOrganization {
+		return operrors.Wrap(operrors.ErrInvalidParent, fmt.Sprintf("parent type %q must be either %q or %q", parent.Type, gcpv1alpha1.ParentTypeFolder, gcpv1alpha1.ParentTypeOrganization))
+	}
+	if !parentIDRegexp.MatchString(parent.ID) {
+		return operrors.Wrap(operrors.ErrInvalidParent, fmt.Sprintf("parent ID %q is not a numeric %s ID", parent.ID, parent.Type))
+	}
+	return nil
+}
+
+// displayNameRegexp matches the display names GCP accepts for projects:
+// 4-30 characters of letters, digits, hyphens, single-quotes, exclamation points and spaces
+var displayNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-'! ]{4,30}$`)
+
+// IsDisplayNameValid checks that the requested display name, if any, has a
+// length and charset GCP accepts for project display names.
+func (c *ProjectClaimAdapter) IsDisplayNameValid() error {
+	if c.projectClaim.Spec.DisplayName == "" {
+		return nil
+	}
+	if !displayNameRegexp.MatchString(c.projectClaim.Spec.DisplayName) {
+		return operrors.Wrap(operrors.ErrInvalidDisplayName, fmt.Sprintf("display name %q must be 4-30 letters, digits, hyphens, single-quotes, exclamation points or spaces", c.projectClaim.Spec.DisplayName))
+	}
+	return nil
+}
+
+// EnsureDisplayNameValid modifies projectClaim.Status.State with result from IsDisplayNameValid.
+// If the display name is invalid it returns an error and sets projectClaim.Status.State to ClaimStatusError.
+func (c *ProjectClaimAdapter) EnsureDisplayNameValid() (gcputil.OperationResult, error) {
+	if err := c.IsDisplayNameValid(); err != nil {
+		c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+		c.StatusUpdate()
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// servicePerimeterRegexp matches full VPC Service Controls perimeter resource
+// names: accessPolicies/<numeric policy>/servicePerimeters/<perimeter>
+var servicePerimeterRegexp = regexp.MustCompile(`^accessPolicies/[0-9]+/servicePerimeters/[a-zA-Z][a-zA-Z0-9_]{0,49}$`)
+
+// IsServicePerimeterValid checks that the requested service perimeter, if any,
+// is a full perimeter resource name.
+func (c *ProjectClaimAdapter) IsServicePerimeterValid() error {
+	if c.projectClaim.Spec.ServicePerimeterName == "" {
+		return nil
+	}
+	if !servicePerimeterRegexp.MatchString(c.projectClaim.Spec.ServicePerimeterName) {
+		return operrors.Wrap(operrors.ErrInvalidServicePerimeter, fmt.Sprintf("service perimeter %q must be of the form accessPolicies/<policy>/servicePerimeters/<name>", c.projectClaim.Spec.ServicePerimeterName))
+	}
+	return nil
+}
+
+// EnsureServicePerimeterValid modifies projectClaim.Status.State with result from IsServicePerimeterValid.
+// If the perimeter name is invalid it returns an error and sets projectClaim.Status.State to ClaimStatusError.
+func (c *ProjectClaimAdapter) EnsureServicePerimeterValid() (gcputil.OperationResult, error) {
+	if err := c.IsServicePerimeterValid(); err != nil {
+		c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+		c.StatusUpdate()
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureParentValid modifies projectClaim.Status.State with result from IsParentValid.
+// If the parent is invalid it returns an error and sets projectClaim.Status.State to ClaimStatusError.
+func (c *ProjectClaimAdapter) EnsureParentValid() (gcputil.OperationResult, error) {
+	if err := c.IsParentValid(); err != nil {
+		c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+		c.StatusUpdate()
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// ValidateSpecConsistency runs all cross-field checks on the claim spec and
+// returns a description of every conflicting combination it finds. Per-field
+// validations live next to their fields; checks spanning several fields are
+// centralized here so new conflicts have one place to go.
+func (c *ProjectClaimAdapter) ValidateSpecConsistency() []string {
+	spec := c.projectClaim.Spec
+	var conflicts []string
+
+	// The operator does not provision CCS projects, so provisioning-time
+	// options cannot take effect on them.
+	if spec.CCS {
+		if spec.Parent != nil {
+			conflicts = append(conflicts, "parent cannot be set on a CCS claim: the project already exists and is not re-parented")
+		}
+		if spec.DisplayName != "" {
+			conflicts = append(conflicts, "displayName cannot be set on a CCS claim: the customer project is not renamed")
+		}
+		if len(spec.ResourceLocationRestriction) > 0 {
+			conflicts = append(conflicts, "resourceLocationRestriction cannot be set on a CCS claim: org policies are not applied to customer projects")
+		}
+		if len(spec.TrustedImageProjects) > 0 {
+			conflicts = append(conflicts, "trustedImageProjects cannot be set on a CCS claim: org policies are not applied to customer projects")
+		}
+		if spec.RequireOSLogin {
+			conflicts = append(conflicts, "requireOSLogin cannot be set on a CCS claim: org policies are not applied to customer projects")
+		}
+		if spec.DisableSerialPortAccess {
+			conflicts = append(conflicts, "disableSerialPortAccess cannot be set on a CCS claim: org policies are not applied to customer projects")
+		}
+	}
+	if spec.DefaultComputeZone != "" && spec.DefaultComputeRegion == "" {
+		conflicts = append(conflicts, "defaultComputeZone requires defaultComputeRegion: the zone cannot be validated without its region")
+	}
+	if spec.DefaultComputeZone != "" && spec.DefaultComputeRegion != "" && !strings.HasPrefix(spec.DefaultComputeZone, spec.DefaultComputeRegion+"-") {
+		conflicts = append(conflicts, fmt.Sprintf("defaultComputeZone %s does not lie within defaultComputeRegion %s", spec.DefaultComputeZone, spec.DefaultComputeRegion))
+	}
+	if spec.SkipCredentialsSecret && spec.WorkloadIdentity == nil {
+		conflicts = append(conflicts, "skipCredentialsSecret requires workloadIdentity: the claim would be left without any credentials")
+	}
+	if spec.SkipCredentialsSecret && spec.CCS {
+		conflicts = append(conflicts, "skipCredentialsSecret cannot be set on a CCS claim: the customer credential secret is referenced via ccsSecretRef")
+	}
+	if notApproved := c.notApprovedAPIs(); len(notApproved) > 0 {
+		conflicts = append(conflicts, fmt.Sprintf("requestedAPIs not in the org-approved catalog: %s; request approval via the %s key of the operator ConfigMap", strings.Join(notApproved, ", "), configmap.ApprovedAPIsKey))
+	}
+
+	return conflicts
+}
+
+// notApprovedAPIs returns the requested APIs that are neither in the
+// org-approved catalog nor part of the mandatory defaults the operator
+// enables anyway. An empty result means the request is allowed, which is
+// also the case when no catalog is configured.
+func (c *ProjectClaimAdapter) notApprovedAPIs() []string {
+	if len(c.projectClaim.Spec.RequestedAPIs) == 0 {
+		return nil
+	}
+	approved, enforced := configmap.ApprovedAPIs(c.client)
+	if !enforced {
+		return nil
+	}
+
+	var notApproved []string
+	for _, api := range c.projectClaim.Spec.RequestedAPIs {
+		if !util.Contains(approved, api) && !util.Contains(projectreference.OSDRequiredAPIS, api) {
+			notApproved = append(notApproved, api)
+		}
+	}
+	return notApproved
+}
+
+// EnsureSpecConsistent halts reconciliation with a single aggregated error when
+// ValidateSpecConsistency finds conflicting spec options, and sets
+// projectClaim.Status.State to ClaimStatusError until they are resolved.
+func (c *ProjectClaimAdapter) EnsureSpecConsistent() (gcputil.OperationResult, error) {
+	conflicts := c.ValidateSpecConsistency()
+	if len(conflicts) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+	c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+	c.StatusUpdate()
+	return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInconsistentSpec, strings.Join(conflicts, "; ")))
+}
+
+// requiredLabels lists the label keys every claim must carry before it is
+// provisioned; empty disables the check. Configured via --required-labels.
+var requiredLabels []string
+
+// SetRequiredLabels sets the label keys every claim must carry.
+func SetRequiredLabels(keys []string) {
+	requiredLabels = keys
+}
+
+// MissingRequiredLabels returns the required label keys the claim does not
+// carry, in the configured order.
+func (c *ProjectClaimAdapter) MissingRequiredLabels() []string {
+	var missing []string
+	for _, key := range requiredLabels {
+		if _, ok := c.projectClaim.Spec.Labels[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// EnsureRequiredLabelsPresent blocks provisioning until the claim carries
+// every label key on the configured required list, so governance labels such
+// as team or cost-center cannot be forgotten. The check is a no-op when no
+// required labels are configured.
+func (c *ProjectClaimAdapter) EnsureRequiredLabelsPresent() (gcputil.OperationResult, error) {
+	missing := c.MissingRequiredLabels()
+	if len(missing) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+	c.projectClaim.Status.State = gcpv1alpha1.ClaimStatusError
+	c.StatusUpdate()
+	return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrMissingRequiredLabels, fmt.Sprintf("claim is missing required labels: %s", strings.Join(missing, ", "))))
+}
+
+// phaseForState maps the fine-grained claim state to the coarse watch-friendly phase
+func phaseForState(state gcpv1alpha1.ClaimStatus) gcpv1alpha1.ClaimPhase {
+	switch state {
+	case gcpv1alpha1.ClaimStatusReady:
+		return gcpv1alpha1.ClaimPhaseActive
+	case gcpv1alpha1.ClaimStatusError:
+		return gcpv1alpha1.ClaimPhaseFailed
+	default:
+		return gcpv1alpha1.ClaimPhaseProvisioning
+	}
+}
+
+// StatusUpdate updates the project claim status. Every call stamps the coarse
+// phase and bumps the reconcile count, so watchers get a reliable edge on each
+// status-changing reconcile; no-op reconciles never reach this method.
 func (c *ProjectClaimAdapter) StatusUpdate() error {
-	if err := c.client.Status().Update(context.TODO(), c.projectClaim); err != nil {
+	c.projectClaim.Status.Phase = phaseForState(c.projectClaim.Status.State)
+	c.projectClaim.Status.ReconcileCount++
+	c.projectClaim.Status.Conditions = condition.PruneConditions(c.projectClaim.Status.Conditions, condition.DefaultConditionRetention)
+	if err := gcputil.UpdateStatus(c.client, c.projectClaim); err != nil {
 		return operrors.Wrap(err, fmt.Sprintf("failed to update ProjectClaim state for %s", c.projectClaim.Name))
 	}
 
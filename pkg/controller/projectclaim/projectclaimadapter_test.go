@@ -3,6 +3,8 @@ package projectclaim_test
 import (
 	"context"
 	er "errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -13,10 +15,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/configmap"
 	"github.com/openshift/gcp-project-operator/pkg/controller/projectclaim"
 	. "github.com/openshift/gcp-project-operator/pkg/controller/projectclaim"
+	"github.com/openshift/gcp-project-operator/pkg/errorbudget"
+	gcputil "github.com/openshift/gcp-project-operator/pkg/util"
+	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
 	"github.com/openshift/gcp-project-operator/pkg/util/mocks"
 	mockconditions "github.com/openshift/gcp-project-operator/pkg/util/mocks/condition"
 	testStructs "github.com/openshift/gcp-project-operator/pkg/util/mocks/structs"
@@ -69,6 +76,80 @@ var _ = Describe("Customresourceadapter", func() {
 		})
 	})
 
+	Context("EnsureProjectLimitNotReached", func() {
+		var (
+			referenceList gcpv1alpha1.ProjectReferenceList
+		)
+		BeforeEach(func() {
+			SetMaxProjectsPerLegalEntity(2)
+			projectClaim.Spec.LegalEntity = gcpv1alpha1.LegalEntity{Name: "fake-legal-entity", ID: "fake-id"}
+			matching := *testStructs.NewProjectReferenceBuilder().GetProjectReference()
+			matching.Spec.LegalEntity = gcpv1alpha1.LegalEntity{Name: "fake-legal-entity", ID: "fake-id"}
+			other := *testStructs.NewProjectReferenceBuilder().GetProjectReference()
+			other.Spec.LegalEntity = gcpv1alpha1.LegalEntity{Name: "other-legal-entity", ID: "other-id"}
+			referenceList = gcpv1alpha1.ProjectReferenceList{Items: []gcpv1alpha1.ProjectReference{matching, other}}
+		})
+		AfterEach(func() {
+			SetMaxProjectsPerLegalEntity(0)
+		})
+
+		Context("when no limit is configured", func() {
+			It("continues processing", func() {
+				SetMaxProjectsPerLegalEntity(0)
+				result, err := adapter.EnsureProjectLimitNotReached()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the legal entity is below the limit", func() {
+			It("continues processing", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, referenceList)
+				result, err := adapter.EnsureProjectLimitNotReached()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the legal entity is at the limit", func() {
+			It("blocks the new claim with ErrProjectLimitReached", func() {
+				second := *referenceList.Items[0].DeepCopy()
+				referenceList.Items = append(referenceList.Items, second)
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, referenceList)
+				result, err := adapter.EnsureProjectLimitNotReached()
+				Expect(err).To(HaveOccurred())
+				Expect(er.Is(err, operrors.ErrProjectLimitReached)).To(BeTrue())
+				Expect(result.RequeueRequest).To(BeTrue())
+			})
+		})
+
+		Context("when the claim's ProjectReference already exists", func() {
+			It("continues processing without counting", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *testStructs.NewProjectReferenceBuilder().GetProjectReference())
+				result, err := adapter.EnsureProjectLimitNotReached()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+	})
+
+	Context("EnsureProjectReferenceExists", func() {
+		Context("when the ProjectReference namespace does not exist", func() {
+			It("returns ErrReferenceNamespaceMissing", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "gcp-project-operator"))
+				_, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).To(HaveOccurred())
+				Expect(er.Is(err, operrors.ErrReferenceNamespaceMissing)).To(BeTrue())
+			})
+		})
+	})
+
 	Context("IsProjectClaimDeletion", func() {
 		It("returns true when DeletionTimeStamp is set on ProjectClaim", func() {
 			deletionTime := metav1.NewTime(time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC))
@@ -82,6 +163,164 @@ var _ = Describe("Customresourceadapter", func() {
 		})
 	})
 
+	Context("EnsureNamespaceActive", func() {
+		var (
+			namespace corev1.Namespace
+		)
+		BeforeEach(func() {
+			namespace = corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: testStructs.TestNamespace},
+				Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+			}
+		})
+
+		Context("when the namespace is active", func() {
+			It("continues processing", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, namespace)
+				result, err := adapter.EnsureNamespaceActive()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the namespace is terminating", func() {
+			BeforeEach(func() {
+				namespace.Status.Phase = corev1.NamespaceTerminating
+			})
+
+			It("records the NamespaceTerminating condition and stops without erroring", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, namespace)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionNamespaceTerminating, corev1.ConditionTrue, "NamespaceTerminating", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureNamespaceActive()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+			})
+
+			Context("and the condition was already recorded", func() {
+				It("stops without writing the status again", func() {
+					projectClaim.Status.Conditions = []gcpv1alpha1.Condition{{
+						Type:   gcpv1alpha1.ConditionNamespaceTerminating,
+						Status: corev1.ConditionTrue,
+					}}
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, namespace)
+					result, err := adapter.EnsureNamespaceActive()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the namespace no longer exists", func() {
+			It("stops without erroring", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, testStructs.TestNamespace)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				result, err := adapter.EnsureNamespaceActive()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+			})
+		})
+	})
+
+	Context("EnsureDrainProcessed", func() {
+		Context("when the projectclaim is not draining", func() {
+			It("continues without touching the conditions", func() {
+				result, err := adapter.EnsureDrainProcessed()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+
+			Context("and the Draining condition is still set", func() {
+				BeforeEach(func() {
+					projectClaim.Status.Conditions = []gcpv1alpha1.Condition{
+						{Type: gcpv1alpha1.ConditionDraining, Status: corev1.ConditionTrue, Reason: "DrainRequested"},
+					}
+				})
+				It("lifts the condition and continues", func() {
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionDraining, corev1.ConditionFalse, "DrainLifted", gomock.Any())
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					result, err := adapter.EnsureDrainProcessed()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when drain is requested", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.Drain = true
+			})
+
+			It("sets the Draining condition and stops processing", func() {
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionDraining, corev1.ConditionTrue, "DrainRequested", gomock.Any())
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureDrainProcessed()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+			})
+
+			Context("and the claim is already draining", func() {
+				BeforeEach(func() {
+					projectClaim.Status.Conditions = []gcpv1alpha1.Condition{
+						{Type: gcpv1alpha1.ConditionDraining, Status: corev1.ConditionTrue, Reason: "DrainRequested", LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Hour))},
+					}
+				})
+
+				It("stops processing so the configuration stays frozen", func() {
+					result, err := adapter.EnsureDrainProcessed()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+				})
+
+				Context("and a revocation grace period is configured", func() {
+					BeforeEach(func() {
+						SetDrainAccessRevokeAfter(time.Hour)
+					})
+					AfterEach(func() {
+						SetDrainAccessRevokeAfter(0)
+					})
+
+					It("revokes the copied credential secret once the grace period expired", func() {
+						mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{})
+						mockClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionDraining, corev1.ConditionTrue, "DrainAccessRevoked", gomock.Any())
+						mockClient.EXPECT().Status().Return(mockStatusWriter)
+						mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+						result, err := adapter.EnsureDrainProcessed()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(result.CancelRequest).To(BeTrue())
+					})
+
+					Context("and the grace period has not expired yet", func() {
+						BeforeEach(func() {
+							projectClaim.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Minute))
+						})
+						It("requeues for the remaining time without revoking", func() {
+							result, err := adapter.EnsureDrainProcessed()
+							Expect(err).ToNot(HaveOccurred())
+							Expect(result.RequeueRequest).To(BeTrue())
+							Expect(result.RequeueDelay).To(BeNumerically(">", 50*time.Minute))
+						})
+					})
+
+					Context("and the secret was already revoked", func() {
+						BeforeEach(func() {
+							projectClaim.Status.Conditions[0].Reason = "DrainAccessRevoked"
+						})
+						It("stops processing without revoking again", func() {
+							result, err := adapter.EnsureDrainProcessed()
+							Expect(err).ToNot(HaveOccurred())
+							Expect(result.CancelRequest).To(BeTrue())
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Context("When the EnsureRegionSupported() is called", func() {
 		Context("if the projectclaim has a supported region", func() {
 			BeforeEach(func() {
@@ -107,6 +346,512 @@ var _ = Describe("Customresourceadapter", func() {
 				Expect(err.Error()).Should(ContainSubstring("RegionNotSupported"))
 			})
 		})
+		Context("if the projectclaim requests multiple supported regions", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.Region = "us-east1"
+				projectClaim.Spec.Regions = []string{"us-east1", "europe-west1"}
+			})
+			It("should return nil", func() {
+				_, err := adapter.EnsureRegionSupported()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if one of multiple requested regions is unsupported", func() {
+			BeforeEach(func() {
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), matcher)
+				projectClaim.Spec.Region = "us-east1"
+				projectClaim.Spec.Regions = []string{"fake-region"}
+			})
+			It("should return err", func() {
+				_, err := adapter.EnsureRegionSupported()
+				Expect(err.Error()).Should(ContainSubstring("RegionNotSupported"))
+			})
+		})
+	})
+
+	Context("When the EnsureParentValid() is called", func() {
+		Context("if the projectclaim has no parent", func() {
+			It("should return nil", func() {
+				_, err := adapter.EnsureParentValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the projectclaim has a folder parent", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+			})
+			It("should return nil", func() {
+				_, err := adapter.EnsureParentValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the projectclaim has an organization parent", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeOrganization, ID: "9876543210"}
+			})
+			It("should return nil", func() {
+				_, err := adapter.EnsureParentValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the parent type is not folder or organization", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: "department", ID: "1234567890"}
+			})
+			It("should return err and set the state to Error", func() {
+				_, err := adapter.EnsureParentValid()
+				Expect(er.Is(err, operrors.ErrInvalidParent)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+		Context("if the parent ID is not numeric", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "folders/1234567890"}
+			})
+			It("should return err and set the state to Error", func() {
+				_, err := adapter.EnsureParentValid()
+				Expect(er.Is(err, operrors.ErrInvalidParent)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+	})
+
+	Context("When the EnsureDisplayNameValid() is called", func() {
+		Context("if the projectclaim has no display name", func() {
+			It("should return nil", func() {
+				_, err := adapter.EnsureDisplayNameValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the projectclaim has a valid display name", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.DisplayName = "My Fancy Project!"
+			})
+			It("should return nil", func() {
+				_, err := adapter.EnsureDisplayNameValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the display name is too short", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.DisplayName = "abc"
+			})
+			It("should return err and set the state to Error", func() {
+				_, err := adapter.EnsureDisplayNameValid()
+				Expect(er.Is(err, operrors.ErrInvalidDisplayName)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+		Context("if the display name contains unsupported characters", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.DisplayName = "not/a/valid/name"
+			})
+			It("should return err and set the state to Error", func() {
+				_, err := adapter.EnsureDisplayNameValid()
+				Expect(er.Is(err, operrors.ErrInvalidDisplayName)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+	})
+
+	Context("When the EnsureServicePerimeterValid() is called", func() {
+		Context("if the projectclaim requests no service perimeter", func() {
+			It("should return nil", func() {
+				_, err := adapter.EnsureServicePerimeterValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the projectclaim has a valid perimeter name", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.ServicePerimeterName = "accessPolicies/123456789/servicePerimeters/regulated_workloads"
+			})
+			It("should return nil", func() {
+				_, err := adapter.EnsureServicePerimeterValid()
+				Expect(err).To(BeNil())
+			})
+		})
+		Context("if the perimeter name is not a full resource name", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.ServicePerimeterName = "regulated_workloads"
+			})
+			It("should return err and set the state to Error", func() {
+				_, err := adapter.EnsureServicePerimeterValid()
+				Expect(er.Is(err, operrors.ErrInvalidServicePerimeter)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+	})
+
+	Context("When the EnsureSpecConsistent() is called", func() {
+		Context("if the projectclaim combines no conflicting options", func() {
+			It("should return nil", func() {
+				_, err := adapter.EnsureSpecConsistent()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		conflictingSpecs := []struct {
+			name   string
+			mutate func(spec *gcpv1alpha1.ProjectClaimSpec)
+		}{
+			{
+				name: "a CCS claim sets a parent",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+				},
+			},
+			{
+				name:   "a CCS claim sets a display name",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) { spec.DisplayName = "My Fancy Project" },
+			},
+			{
+				name:   "a CCS claim restricts resource locations",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) { spec.ResourceLocationRestriction = []string{"us-east1"} },
+			},
+			{
+				name: "a CCS claim restricts trusted image projects",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.TrustedImageProjects = []string{"projects/golden-images"}
+				},
+			},
+			{
+				name:   "a CCS claim requires OS Login",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) { spec.RequireOSLogin = true },
+			},
+			{
+				name:   "a CCS claim disables serial port access",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) { spec.DisableSerialPortAccess = true },
+			},
+			{
+				name: "credentials are skipped without workload identity",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.CCS = false
+					spec.SkipCredentialsSecret = true
+					spec.WorkloadIdentity = nil
+				},
+			},
+			{
+				name: "a CCS claim skips the credential secret",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.SkipCredentialsSecret = true
+					spec.WorkloadIdentity = &gcpv1alpha1.WorkloadIdentity{PoolID: "fake-pool"}
+				},
+			},
+			{
+				name: "a default compute zone is set without a region",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.CCS = false
+					spec.DefaultComputeZone = "us-east1-b"
+				},
+			},
+			{
+				name: "the default compute zone lies outside the default region",
+				mutate: func(spec *gcpv1alpha1.ProjectClaimSpec) {
+					spec.CCS = false
+					spec.DefaultComputeRegion = "us-east1"
+					spec.DefaultComputeZone = "europe-west1-b"
+				},
+			},
+		}
+		for i := range conflictingSpecs {
+			conflict := conflictingSpecs[i]
+			Context("if "+conflict.name, func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					projectClaim.Spec.CCS = true
+					conflict.mutate(&projectClaim.Spec)
+				})
+				It("should return err and set the state to Error", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(er.Is(err, operrors.ErrInconsistentSpec)).To(BeTrue())
+					Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+				})
+			})
+		}
+
+		Context("if the projectclaim requests additional APIs", func() {
+			approvedCatalog := corev1.ConfigMap{
+				Data: map[string]string{configmap.ApprovedAPIsKey: "bigquery.googleapis.com, pubsub.googleapis.com"},
+			}
+
+			Context("and all of them are in the approved catalog", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, approvedCatalog)
+					projectClaim.Spec.RequestedAPIs = []string{"bigquery.googleapis.com", "pubsub.googleapis.com"}
+				})
+				It("should return nil", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("and none of them is in the approved catalog", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, approvedCatalog)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					projectClaim.Spec.RequestedAPIs = []string{"gameservices.googleapis.com"}
+				})
+				It("should return err naming the API and the approval process", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(er.Is(err, operrors.ErrInconsistentSpec)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("gameservices.googleapis.com"))
+					Expect(err.Error()).To(ContainSubstring(configmap.ApprovedAPIsKey))
+					Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+				})
+			})
+
+			Context("and the list mixes approved and non-approved APIs", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, approvedCatalog)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					projectClaim.Spec.RequestedAPIs = []string{"bigquery.googleapis.com", "gameservices.googleapis.com"}
+				})
+				It("should only reject the non-approved API", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(er.Is(err, operrors.ErrInconsistentSpec)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("gameservices.googleapis.com"))
+					Expect(err.Error()).ToNot(ContainSubstring("catalog: bigquery.googleapis.com"))
+				})
+			})
+
+			Context("and a mandatory default API is requested explicitly", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, approvedCatalog)
+					projectClaim.Spec.RequestedAPIs = []string{"dns.googleapis.com"}
+				})
+				It("should bypass the catalog check", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("and no approved catalog is configured", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.ConfigMap{})
+					projectClaim.Spec.RequestedAPIs = []string{"gameservices.googleapis.com"}
+				})
+				It("should allow any requested API", func() {
+					_, err := adapter.EnsureSpecConsistent()
+					Expect(err).To(BeNil())
+				})
+			})
+		})
+
+		Context("if several options conflict at once", func() {
+			BeforeEach(func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				projectClaim.Spec.CCS = true
+				projectClaim.Spec.DisplayName = "My Fancy Project"
+				projectClaim.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+			})
+			It("should aggregate all conflicts into one error", func() {
+				_, err := adapter.EnsureSpecConsistent()
+				Expect(er.Is(err, operrors.ErrInconsistentSpec)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("parent cannot be set on a CCS claim"))
+				Expect(err.Error()).To(ContainSubstring("displayName cannot be set on a CCS claim"))
+			})
+		})
+	})
+
+	Context("When the EnsureRequiredLabelsPresent() is called", func() {
+		Context("if no required labels are configured", func() {
+			It("should return nil", func() {
+				_, err := adapter.EnsureRequiredLabelsPresent()
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Context("if required labels are configured", func() {
+			BeforeEach(func() {
+				SetRequiredLabels([]string{"team", "cost-center"})
+			})
+			AfterEach(func() {
+				SetRequiredLabels(nil)
+			})
+
+			Context("and the projectclaim carries none of them", func() {
+				BeforeEach(func() {
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				})
+				It("should return err listing every missing key and set the state to Error", func() {
+					_, err := adapter.EnsureRequiredLabelsPresent()
+					Expect(er.Is(err, operrors.ErrMissingRequiredLabels)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("team, cost-center"))
+					Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+				})
+			})
+
+			Context("and the projectclaim carries only part of them", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.Labels = map[string]string{"team": "fake-team"}
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				})
+				It("should only report the missing key", func() {
+					_, err := adapter.EnsureRequiredLabelsPresent()
+					Expect(er.Is(err, operrors.ErrMissingRequiredLabels)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("cost-center"))
+					Expect(err.Error()).ToNot(ContainSubstring("team"))
+				})
+			})
+
+			Context("and the projectclaim carries all of them", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.Labels = map[string]string{"team": "fake-team", "cost-center": "fake-center"}
+				})
+				It("should return nil", func() {
+					_, err := adapter.EnsureRequiredLabelsPresent()
+					Expect(err).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Context("EnsureProjectReferenceSpecApplied", func() {
+		var (
+			matchingReference *gcpv1alpha1.ProjectReference
+		)
+		BeforeEach(func() {
+			matchingReference = testStructs.NewProjectReferenceBuilder().GetProjectReference()
+			matchingReference.Spec.ProjectClaimCRLink = gcpv1alpha1.NamespacedName{
+				Name:      projectClaim.GetName(),
+				Namespace: projectClaim.GetNamespace(),
+			}
+			matchingReference.Spec.LegalEntity = projectClaim.Spec.LegalEntity
+		})
+
+		Context("when the ProjectReference does not exist", func() {
+			It("continues processing", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				result, err := adapter.EnsureProjectReferenceSpecApplied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the ProjectReference spec matches the claim", func() {
+			It("continues processing without publishing a diff", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+				result, err := adapter.EnsureProjectReferenceSpecApplied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+				Expect(projectClaim.GetAnnotations()).ToNot(HaveKey(PendingDiffAnnotation))
+			})
+		})
+
+		Context("when the ProjectReference spec differs from the claim", func() {
+			BeforeEach(func() {
+				matchingReference.Spec.LegalEntity.ID = "outdatedLegalEntityID"
+			})
+
+			Context("when the diff is not approved", func() {
+				It("publishes the diff on the claim without applying", func() {
+					matcher := testStructs.NewProjectClaimMatcher()
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+					mockClient.EXPECT().Update(gomock.Any(), matcher)
+					result, err := adapter.EnsureProjectReferenceSpecApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+					Expect(matcher.ActualProjectClaim.GetAnnotations()[PendingDiffAnnotation]).To(ContainSubstring("outdatedLegalEntityID"))
+				})
+
+				It("stops processing if the diff is already published", func() {
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference).Times(2)
+					matcher := testStructs.NewProjectClaimMatcher()
+					mockClient.EXPECT().Update(gomock.Any(), matcher)
+					_, err := adapter.EnsureProjectReferenceSpecApplied()
+					Expect(err).ToNot(HaveOccurred())
+					result, err := adapter.EnsureProjectReferenceSpecApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+				})
+			})
+
+			Context("when the diff is approved", func() {
+				BeforeEach(func() {
+					projectClaim.SetAnnotations(map[string]string{
+						ApproveAnnotation:     "true",
+						PendingDiffAnnotation: "some-diff",
+					})
+				})
+				It("applies the spec and clears the pending diff", func() {
+					referenceMatcher := testStructs.NewProjectReferenceMatcher()
+					claimMatcher := testStructs.NewProjectClaimMatcher()
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+					mockClient.EXPECT().Update(gomock.Any(), referenceMatcher)
+					mockClient.EXPECT().Update(gomock.Any(), claimMatcher)
+					result, err := adapter.EnsureProjectReferenceSpecApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+					Expect(referenceMatcher.ActualProjectReference.Spec.LegalEntity.ID).To(Equal(projectClaim.Spec.LegalEntity.ID))
+					Expect(claimMatcher.ActualProjectClaim.GetAnnotations()).ToNot(HaveKey(PendingDiffAnnotation))
+					Expect(claimMatcher.ActualProjectClaim.GetAnnotations()).ToNot(HaveKey(ApproveAnnotation))
+				})
+			})
+		})
+
+		Context("when an optional claim field was cleared", func() {
+			BeforeEach(func() {
+				matchingReference.Spec.DisplayName = "Legacy Display Name"
+			})
+
+			It("publishes a diff for the removal", func() {
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+				mockClient.EXPECT().Update(gomock.Any(), matcher)
+				result, err := adapter.EnsureProjectReferenceSpecApplied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+				Expect(matcher.ActualProjectClaim.GetAnnotations()[PendingDiffAnnotation]).To(ContainSubstring("Legacy Display Name"))
+			})
+
+			It("clears the reference field once the removal is approved", func() {
+				projectClaim.SetAnnotations(map[string]string{
+					ApproveAnnotation:     "true",
+					PendingDiffAnnotation: "some-diff",
+				})
+				referenceMatcher := testStructs.NewProjectReferenceMatcher()
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+				mockClient.EXPECT().Update(gomock.Any(), referenceMatcher)
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureProjectReferenceSpecApplied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+				Expect(referenceMatcher.ActualProjectReference.Spec.DisplayName).To(BeEmpty())
+			})
+		})
+
+		Context("when only an immutable field differs", func() {
+			BeforeEach(func() {
+				matchingReference.Spec.CCS = true
+			})
+
+			It("keeps the reference value and publishes no diff", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+				result, err := adapter.EnsureProjectReferenceSpecApplied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+				Expect(projectClaim.GetAnnotations()).ToNot(HaveKey(PendingDiffAnnotation))
+			})
+		})
 	})
 
 	Context("FinalizeProjectClaim", func() {
@@ -122,6 +867,25 @@ var _ = Describe("Customresourceadapter", func() {
 			BeforeEach(func() {
 				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
 				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: projectClaim.GetName() + "-gcp-credentials", Namespace: projectClaim.GetNamespace()},
+				})
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1)
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+			})
+
+			It("deletes the copied credential secret and removes the finalizer", func() {
+				crStatus, err := adapter.FinalizeProjectClaim()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(crStatus).To(Equal(ObjectModified))
+				Expect(matcher.ActualProjectClaim.Finalizers).ToNot(ContainElement(ProjectClaimFinalizer))
+			})
+		})
+
+		Context("when the project reference and the copied secret don't exist", func() {
+			BeforeEach(func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound).Times(2)
 				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
 			})
 
@@ -133,9 +897,30 @@ var _ = Describe("Customresourceadapter", func() {
 			})
 		})
 
+		Context("when the claim provisioned a GCP project", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.GCPProjectID = "fake-id"
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound).Times(2)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+			})
+
+			It("records the recovery window before removing the finalizer", func() {
+				crStatus, err := adapter.FinalizeProjectClaim()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(crStatus).To(Equal(ObjectModified))
+				Expect(projectClaim.Status.Recoverable).To(BeTrue())
+				Expect(projectClaim.Status.RecoveryDeadline.Time).To(BeTemporally("~", time.Now().Add(30*24*time.Hour), time.Minute))
+			})
+		})
+
 		Context("when the project reference exists", func() {
 			It("there is no error and claim object is not deleted", func() {
-				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *testStructs.NewProjectReferenceBuilder().GetProjectReference()).Times(2)
+				matchingReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				matchingReference.Spec.ProjectClaimCRLink = gcpv1alpha1.NamespacedName{Name: projectClaim.Name, Namespace: projectClaim.Namespace}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference).Times(2)
 				mockClient.EXPECT().Delete(gomock.Any(), &testStructs.ProjectReferenceMatcher{}).Times(1)
 				_, err := adapter.EnsureProjectReferenceExists()
 				Expect(err).ToNot(HaveOccurred())
@@ -146,31 +931,381 @@ var _ = Describe("Customresourceadapter", func() {
 		})
 	})
 
+	Context("EnsureCredentialSecretCopied", func() {
+		var (
+			sourceSecret corev1.Secret
+		)
+		BeforeEach(func() {
+			projectClaim.Spec.GCPCredentialSecret = gcpv1alpha1.NamespacedName{Name: "fake-secret", Namespace: testStructs.TestNamespace}
+			sourceSecret = corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-secret", Namespace: testStructs.TestNamespace},
+				Data:       map[string][]byte{"osServiceAccount.json": []byte("fakedata")},
+			}
+		})
+
+		Context("when the claim opts out of the credentials secret", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.SkipCredentialsSecret = true
+			})
+
+			It("continues processing when no leftover secrets exist", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "fake-secret")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound).Times(2)
+				result, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+
+			It("deletes the leftover copy and source and records the condition", func() {
+				copySecret := corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      projectClaim.GetName() + "-gcp-credentials",
+						Namespace: testStructs.TestNamespace,
+						OwnerReferences: []metav1.OwnerReference{{
+							Kind: "ProjectClaim",
+							Name: projectClaim.GetName(),
+							UID:  projectClaim.GetUID(),
+						}},
+					},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, copySecret)
+				managedSource := sourceSecret
+				managedSource.SetLabels(map[string]string{gcputil.ManagedByLabel: gcputil.ManagedByValue})
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, managedSource)
+				deleted := []string{}
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, obj runtime.Object) {
+					deleted = append(deleted, obj.(*corev1.Secret).GetName())
+				}).Times(2)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialSecretRemoved, corev1.ConditionTrue, "CredentialSecretRemoved", gomock.Any())
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(ConsistOf(projectClaim.GetName()+"-gcp-credentials", "fake-secret"))
+			})
+
+			It("leaves secrets the operator does not own alone", func() {
+				unownedCopy := corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: projectClaim.GetName() + "-gcp-credentials", Namespace: testStructs.TestNamespace},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, unownedCopy)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				result, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the source secret does not exist yet", func() {
+			It("continues processing", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "fake-secret")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				_, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the copy does not exist", func() {
+			It("creates it owned by the claim", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				notFound := errors.NewNotFound(schema.GroupResource{}, projectClaim.GetName()+"-gcp-credentials")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				var created *corev1.Secret
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, obj runtime.Object) {
+					created = obj.(*corev1.Secret)
+				})
+				_, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created.GetName()).To(Equal(projectClaim.GetName() + "-gcp-credentials"))
+				Expect(created.GetNamespace()).To(Equal(projectClaim.GetNamespace()))
+				Expect(created.Data).To(Equal(sourceSecret.Data))
+				Expect(created.OwnerReferences).To(HaveLen(1))
+				Expect(created.OwnerReferences[0].Kind).To(Equal("ProjectClaim"))
+				Expect(created.OwnerReferences[0].Name).To(Equal(projectClaim.GetName()))
+			})
+		})
+
+		Context("when the source secret rotated", func() {
+			It("syncs the copy", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				staleCopy := corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: projectClaim.GetName() + "-gcp-credentials", Namespace: testStructs.TestNamespace},
+					Data:       map[string][]byte{"osServiceAccount.json": []byte("olddata")},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, staleCopy)
+				var updated *corev1.Secret
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, obj runtime.Object) {
+					updated = obj.(*corev1.Secret)
+				})
+				_, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated.Data).To(Equal(sourceSecret.Data))
+			})
+		})
+
+		Context("when the copy is in sync", func() {
+			It("does nothing", func() {
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				upToDateCopy := corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: projectClaim.GetName() + "-gcp-credentials", Namespace: testStructs.TestNamespace},
+					Data:       sourceSecret.Data,
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, upToDateCopy)
+				_, err := adapter.EnsureCredentialSecretCopied()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureCredentialSecretPresent", func() {
+		var (
+			sourceSecret corev1.Secret
+		)
+		BeforeEach(func() {
+			projectClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+			projectClaim.Spec.GCPCredentialSecret = gcpv1alpha1.NamespacedName{Name: "fake-secret", Namespace: testStructs.TestNamespace}
+			sourceSecret = corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-secret", Namespace: testStructs.TestNamespace},
+				Data:       map[string][]byte{"osServiceAccount.json": []byte("fakedata")},
+			}
+		})
+
+		Context("when the claim is not Ready yet", func() {
+			It("continues processing", func() {
+				projectClaim.Status.State = gcpv1alpha1.ClaimStatusPendingProject
+				result, err := adapter.EnsureCredentialSecretPresent()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the copied secret still exists", func() {
+			It("continues processing", func() {
+				copy := corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: projectClaim.GetName() + "-gcp-credentials", Namespace: testStructs.TestNamespace},
+					Data:       sourceSecret.Data,
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, copy)
+				result, err := adapter.EnsureCredentialSecretPresent()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the copied secret was deleted", func() {
+			It("restores it and sets the CredentialSecretRecreated condition", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, projectClaim.GetName()+"-gcp-credentials")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, sourceSecret)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				var created *corev1.Secret
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, obj runtime.Object) {
+					created = obj.(*corev1.Secret)
+				})
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialSecretRecreated, corev1.ConditionTrue, "CredentialSecretRecreated", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureCredentialSecretPresent()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created.GetName()).To(Equal(projectClaim.GetName() + "-gcp-credentials"))
+				Expect(created.Data).To(Equal(sourceSecret.Data))
+			})
+		})
+
+		Context("when the source secret is gone too", func() {
+			It("requeues until the key is re-minted", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "fake-secret")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound).Times(2)
+				result, err := adapter.EnsureCredentialSecretPresent()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+				Expect(result.RequeueDelay).To(Equal(30 * time.Second))
+			})
+		})
+	})
+
+	Context("EnsureDependenciesReady", func() {
+		var (
+			depClaim gcpv1alpha1.ProjectClaim
+		)
+		BeforeEach(func() {
+			projectClaim.Spec.DependsOn = []gcpv1alpha1.NamespacedName{{Name: "hub", Namespace: testStructs.TestNamespace}}
+			depClaim = gcpv1alpha1.ProjectClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "hub", Namespace: testStructs.TestNamespace},
+			}
+		})
+
+		Context("when the claim has no dependencies", func() {
+			It("continues processing", func() {
+				projectClaim.Spec.DependsOn = nil
+				result, err := adapter.EnsureDependenciesReady()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when a dependency claim is not Ready yet", func() {
+			It("sets the WaitingForDependencies condition and requeues", func() {
+				depClaim.Status.State = gcpv1alpha1.ClaimStatusPendingProject
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, depClaim).Times(2)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionTrue, "WaitingForDependencies", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureDependenciesReady()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+				Expect(result.RequeueDelay).To(Equal(30 * time.Second))
+			})
+		})
+
+		Context("when a dependency claim does not exist", func() {
+			It("sets the WaitingForDependencies condition and requeues", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "hub")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound).Times(2)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionTrue, "WaitingForDependencies", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureDependenciesReady()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+			})
+		})
+
+		Context("when all dependency claims are Ready", func() {
+			It("continues processing", func() {
+				depClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, depClaim)
+				result, err := adapter.EnsureDependenciesReady()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+
+			Context("and the claim was waiting before", func() {
+				It("lowers the WaitingForDependencies condition", func() {
+					projectClaim.Status.Conditions = []gcpv1alpha1.Condition{{
+						Type:   gcpv1alpha1.ConditionWaitingForDependencies,
+						Status: corev1.ConditionTrue,
+					}}
+					depClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, depClaim)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionFalse, "DependenciesReady", gomock.Any()).Times(1)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					result, err := adapter.EnsureDependenciesReady()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the dependsOn references form a cycle", func() {
+			It("sets the condition and fails with ErrDependencyCycle", func() {
+				depClaim.Spec.DependsOn = []gcpv1alpha1.NamespacedName{{
+					Name:      projectClaim.GetName(),
+					Namespace: projectClaim.GetNamespace(),
+				}}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, depClaim).Times(2)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionWaitingForDependencies, corev1.ConditionTrue, "DependencyCycle", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureDependenciesReady()
+				Expect(err).To(HaveOccurred())
+				Expect(er.Is(err, operrors.ErrDependencyCycle)).To(BeTrue())
+			})
+		})
+	})
+
 	Context("EnsureProjectClaimInitialized", func() {
 		Context("When conditions are already existing", func() {
 			BeforeEach(func() {
-				projectClaim = testStructs.NewProjectClaimBuilder().Initialized().GetProjectClaim()
+				projectClaim = testStructs.NewProjectClaimBuilder().Initialized().GetProjectClaim()
+			})
+
+			It("doesn't update ProjectClaim status", func() {
+				result, err := adapter.EnsureProjectClaimInitialized()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(Equal(false))
+			})
+		})
+		Context("When conditions are not set", func() {
+			BeforeEach(func() {
+				projectClaim.Status.Conditions = nil
+			})
+			It("Initializes them with an empty array", func() {
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), matcher)
+				result, err := adapter.EnsureProjectClaimInitialized()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(Equal(true))
+				Expect(matcher.ActualProjectClaim.Status.Conditions).NotTo(Equal(nil))
+				Expect(len(matcher.ActualProjectClaim.Status.Conditions)).To(Equal(0))
+			})
+
+			It("stamps the Created timeline milestone", func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureProjectClaimInitialized()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(projectClaim.Status.Timeline.Created.IsZero()).To(BeFalse())
+			})
+
+			It("does not overwrite an already stamped Created milestone", func() {
+				created := metav1.NewTime(time.Now().Add(-time.Hour))
+				projectClaim.Status.Timeline.Created = created
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureProjectClaimInitialized()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(projectClaim.Status.Timeline.Created).To(Equal(created))
+			})
+		})
+	})
+
+	Context("EnsureAnnotationConditions", func() {
+		BeforeEach(func() {
+			SetAnnotationConditions(map[string]gcpv1alpha1.ConditionType{"example.com/approved": "Approved"})
+		})
+
+		AfterEach(func() {
+			SetAnnotationConditions(map[string]gcpv1alpha1.ConditionType{})
+		})
+
+		Context("when the mapped annotation is set", func() {
+			BeforeEach(func() {
+				projectClaim.SetAnnotations(map[string]string{"example.com/approved": "true"})
 			})
 
-			It("doesn't update ProjectClaim status", func() {
-				result, err := adapter.EnsureProjectClaimInitialized()
+			It("mirrors the annotation as a condition", func() {
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionType("Approved")).Return(nil, false)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionType("Approved"), corev1.ConditionTrue, "AnnotationSet", "true").Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureAnnotationConditions()
 				Expect(err).NotTo(HaveOccurred())
-				Expect(result.CancelRequest).To(Equal(false))
+				Expect(result.CancelRequest).To(BeFalse())
 			})
 		})
-		Context("When conditions are not set", func() {
-			BeforeEach(func() {
-				projectClaim.Status.Conditions = nil
-			})
-			It("Initializes them with an empty array", func() {
-				matcher := testStructs.NewProjectClaimMatcher()
+
+		Context("when the annotation was removed again", func() {
+			It("clears the previously mirrored condition", func() {
+				existing := &gcpv1alpha1.Condition{Type: "Approved", Status: corev1.ConditionTrue}
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionType("Approved")).Return(existing, true)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionType("Approved"), corev1.ConditionFalse, "AnnotationRemoved", "").Times(1)
 				mockClient.EXPECT().Status().Return(mockStatusWriter)
-				mockStatusWriter.EXPECT().Update(gomock.Any(), matcher)
-				result, err := adapter.EnsureProjectClaimInitialized()
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureAnnotationConditions()
 				Expect(err).NotTo(HaveOccurred())
-				Expect(result.CancelRequest).To(Equal(true))
-				Expect(matcher.ActualProjectClaim.Status.Conditions).NotTo(Equal(nil))
-				Expect(len(matcher.ActualProjectClaim.Status.Conditions)).To(Equal(0))
+			})
+		})
+
+		Context("when the annotation is absent and no condition exists", func() {
+			It("does not touch the status", func() {
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionType("Approved")).Return(nil, false)
+				result, err := adapter.EnsureAnnotationConditions()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
 			})
 		})
 	})
@@ -202,6 +1337,84 @@ var _ = Describe("Customresourceadapter", func() {
 		})
 	})
 
+	Context("EnsureLinkConsistency", func() {
+		Context("when ProjectReferenceCRLink is not set yet", func() {
+			It("continues processing without fetching anything", func() {
+				result, err := adapter.EnsureLinkConsistency()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when ProjectReferenceCRLink is set", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.ProjectReferenceCRLink.Name = projectClaim.GetNamespace() + "-" + projectClaim.GetName()
+				projectClaim.Spec.ProjectReferenceCRLink.Namespace = gcpv1alpha1.ProjectReferenceNamespace
+			})
+
+			It("continues processing when both links agree", func() {
+				reference := gcpv1alpha1.ProjectReference{
+					Spec: gcpv1alpha1.ProjectReferenceSpec{
+						ProjectClaimCRLink: gcpv1alpha1.NamespacedName{
+							Name:      projectClaim.GetName(),
+							Namespace: projectClaim.GetNamespace(),
+						},
+					},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, reference)
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionLinkInconsistent).Return(nil, false)
+				result, err := adapter.EnsureLinkConsistency()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+
+			It("repairs a stale claim link pointing at a missing reference", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+				result, err := adapter.EnsureLinkConsistency()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+				Expect(matcher.ActualProjectClaim.Spec.ProjectReferenceCRLink.Name).To(Equal(projectClaim.GetNamespace() + "-" + projectClaim.GetName()))
+			})
+
+			It("repairs a stale back-link pointing at a missing claim", func() {
+				reference := gcpv1alpha1.ProjectReference{
+					Spec: gcpv1alpha1.ProjectReferenceSpec{
+						ProjectClaimCRLink: gcpv1alpha1.NamespacedName{Name: "gone-claim", Namespace: "gone-namespace"},
+					},
+				}
+				notFound := errors.NewNotFound(schema.GroupResource{}, "gone-claim")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, reference)
+				mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Name: "gone-claim", Namespace: "gone-namespace"}, gomock.Any()).Return(notFound)
+				matcher := testStructs.NewProjectReferenceMatcher()
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+				result, err := adapter.EnsureLinkConsistency()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.CancelRequest).To(BeTrue())
+				Expect(matcher.ActualProjectReference.Spec.ProjectClaimCRLink.Name).To(Equal(projectClaim.GetName()))
+				Expect(matcher.ActualProjectReference.Spec.ProjectClaimCRLink.Namespace).To(Equal(projectClaim.GetNamespace()))
+			})
+
+			It("sets the LinkInconsistent condition when the back-link names another existing claim", func() {
+				reference := gcpv1alpha1.ProjectReference{
+					Spec: gcpv1alpha1.ProjectReferenceSpec{
+						ProjectClaimCRLink: gcpv1alpha1.NamespacedName{Name: "other-claim", Namespace: "other-namespace"},
+					},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, reference)
+				mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Name: "other-claim", Namespace: "other-namespace"}, gomock.Any()).SetArg(2, gcpv1alpha1.ProjectClaim{})
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionLinkInconsistent, corev1.ConditionTrue, "LinkInconsistent", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureLinkConsistency()
+				Expect(err).To(HaveOccurred())
+				Expect(er.Is(err, operrors.ErrMismatchedProjectReference)).To(BeTrue())
+			})
+		})
+	})
+
 	Context("EnsureFinalizer", func() {
 		Context("when finalizer is not set", func() {
 			BeforeEach(func() {
@@ -227,6 +1440,101 @@ var _ = Describe("Customresourceadapter", func() {
 				Expect(result.CancelRequest).To(Equal(false))
 			})
 		})
+
+		Context("when a custom finalizer name is configured", func() {
+			customFinalizer := "finalizer.migration.openshift.io"
+			BeforeEach(func() {
+				projectclaim.SetProjectClaimFinalizerName(customFinalizer)
+			})
+			AfterEach(func() {
+				projectclaim.SetProjectClaimFinalizerName("")
+			})
+			It("sets the custom finalizer", func() {
+				projectClaim.Finalizers = []string{}
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+				result, err := adapter.EnsureFinalizer()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(Equal(true))
+				Expect(projectClaim.Finalizers).To(ContainElement(customFinalizer))
+			})
+			It("removes the custom finalizer", func() {
+				projectClaim.Finalizers = []string{customFinalizer}
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Update(gomock.Any(), matcher).Times(1)
+				Expect(adapter.EnsureFinalizerDeleted()).To(Succeed())
+				Expect(matcher.ActualProjectClaim.Finalizers).ToNot(ContainElement(customFinalizer))
+			})
+		})
+	})
+
+	Context("EnsureTemplateApplied", func() {
+		Context("when the claim has no templateRef", func() {
+			It("continues processing without fetching anything", func() {
+				result, err := adapter.EnsureTemplateApplied()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when a templateRef is set", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.TemplateRef = &gcpv1alpha1.NamespacedName{Name: "fake-template", Namespace: gcpv1alpha1.ProjectReferenceNamespace}
+				projectClaim.Spec.RequestedAPIs = []string{"claim.googleapis.com"}
+				projectClaim.Spec.Labels = map[string]string{"team": "claim-team"}
+			})
+
+			It("merges the template defaults into the generated ProjectReference", func() {
+				template := gcpv1alpha1.ProjectReference{
+					Spec: gcpv1alpha1.ProjectReferenceSpec{
+						RequestedAPIs:  []string{"template.googleapis.com", "claim.googleapis.com"},
+						Labels:         map[string]string{"team": "template-team", "env": "integration"},
+						RequireOSLogin: true,
+					},
+				}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, template)
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionTemplateFound).Return(nil, false)
+				result, err := adapter.EnsureTemplateApplied()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				matcher := testStructs.NewProjectReferenceMatcher()
+				mockClient.EXPECT().Create(gomock.Any(), matcher)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err = adapter.EnsureProjectReferenceExists()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(matcher.ActualProjectReference.Spec.RequestedAPIs).To(Equal([]string{"claim.googleapis.com", "template.googleapis.com"}))
+				Expect(matcher.ActualProjectReference.Spec.Labels).To(Equal(map[string]string{"team": "claim-team", "env": "integration"}))
+				Expect(matcher.ActualProjectReference.Spec.RequireOSLogin).To(BeTrue())
+			})
+
+			It("requeues with an error and sets a condition when the template is missing", func() {
+				notFound := errors.NewNotFound(schema.GroupResource{}, "fake-template")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionTemplateFound, corev1.ConditionFalse, "TemplateMissing", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureTemplateApplied()
+				Expect(err).To(HaveOccurred())
+				Expect(er.Is(err, operrors.ErrTemplateMissing)).To(BeTrue())
+			})
+
+			It("marks the condition resolved once the template appears", func() {
+				template := gcpv1alpha1.ProjectReference{}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, template)
+				existing := &gcpv1alpha1.Condition{Type: gcpv1alpha1.ConditionTemplateFound, Status: corev1.ConditionFalse}
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionTemplateFound).Return(existing, true)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionTemplateFound, corev1.ConditionTrue, "TemplateFound", gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := adapter.EnsureTemplateApplied()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CancelRequest).To(BeFalse())
+			})
+		})
 	})
 
 	Context("EnsureProjectReferenceExists()", func() {
@@ -238,6 +1546,8 @@ var _ = Describe("Customresourceadapter", func() {
 			It("creates a ProjectReference", func() {
 				matcher := testStructs.NewProjectReferenceMatcher()
 				mockClient.EXPECT().Create(gomock.Any(), matcher)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
 				_, err := adapter.EnsureProjectReferenceExists()
 				Expect(err).ToNot(HaveOccurred())
 				Expect(matcher.ActualProjectReference.Name).To(Equal(projectClaim.GetNamespace() + "-" + projectClaim.GetName()))
@@ -246,15 +1556,225 @@ var _ = Describe("Customresourceadapter", func() {
 				Expect(matcher.ActualProjectReference.Spec.ProjectClaimCRLink.Namespace).To(Equal(projectClaim.Namespace))
 				Expect(matcher.ActualProjectReference.Spec.LegalEntity).To(Equal(projectClaim.Spec.LegalEntity))
 			})
+
+			Context("when the reference-name annotation is set", func() {
+				BeforeEach(func() {
+					projectClaim.SetAnnotations(map[string]string{projectclaim.ReferenceNameAnnotation: "legacy-reference"})
+				})
+
+				It("creates the ProjectReference under the annotated name", func() {
+					matcher := testStructs.NewProjectReferenceMatcher()
+					mockClient.EXPECT().Create(gomock.Any(), matcher)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					_, err := adapter.EnsureProjectReferenceExists()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(matcher.ActualProjectReference.Name).To(Equal("legacy-reference"))
+				})
+			})
+
+			Context("when the reference-name annotation is not a legal resource name", func() {
+				BeforeEach(func() {
+					projectClaim.SetAnnotations(map[string]string{projectclaim.ReferenceNameAnnotation: "Not_A_Legal_Name!"})
+				})
+
+				It("falls back to the computed name", func() {
+					matcher := testStructs.NewProjectReferenceMatcher()
+					mockClient.EXPECT().Create(gomock.Any(), matcher)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					_, err := adapter.EnsureProjectReferenceExists()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(matcher.ActualProjectReference.Name).To(Equal(projectClaim.GetNamespace() + "-" + projectClaim.GetName()))
+				})
+			})
+
+			It("stamps the ReferenceCreated timeline milestone after Created", func() {
+				projectClaim.Status.Timeline.Created = metav1.NewTime(time.Now().Add(-time.Minute))
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any())
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(projectClaim.Status.Timeline.ReferenceCreated.IsZero()).To(BeFalse())
+				Expect(projectClaim.Status.Timeline.ReferenceCreated.Time.Before(projectClaim.Status.Timeline.Created.Time)).To(BeFalse())
+			})
+
+			It("does not overwrite the milestone when the reference is recreated", func() {
+				referenceCreated := metav1.NewTime(time.Now().Add(-time.Hour))
+				projectClaim.Status.Timeline.ReferenceCreated = referenceCreated
+				mockClient.EXPECT().Create(gomock.Any(), gomock.Any())
+				_, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(projectClaim.Status.Timeline.ReferenceCreated).To(Equal(referenceCreated))
+			})
+
+			Context("when annotation templates are configured", func() {
+				BeforeEach(func() {
+					err := SetReferenceAnnotationTemplates(map[string]string{
+						"team":   "team={{.Spec.Labels.team}}",
+						"region": "{{.Spec.Region}}",
+					})
+					Expect(err).ToNot(HaveOccurred())
+					projectClaim.Spec.Labels = map[string]string{"team": "fake-team"}
+				})
+				AfterEach(func() {
+					Expect(SetReferenceAnnotationTemplates(nil)).To(Succeed())
+				})
+
+				It("renders the templated annotations on the created reference", func() {
+					matcher := testStructs.NewProjectReferenceMatcher()
+					mockClient.EXPECT().Create(gomock.Any(), matcher)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					_, err := adapter.EnsureProjectReferenceExists()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(matcher.ActualProjectReference.GetAnnotations()["team"]).To(Equal("team=fake-team"))
+					Expect(matcher.ActualProjectReference.GetAnnotations()["region"]).To(Equal("us-east1"))
+				})
+
+				Context("when the claim does not set a templated field", func() {
+					BeforeEach(func() {
+						projectClaim.Spec.Labels = nil
+					})
+					It("skips the annotation instead of failing", func() {
+						matcher := testStructs.NewProjectReferenceMatcher()
+						mockClient.EXPECT().Create(gomock.Any(), matcher)
+						mockClient.EXPECT().Status().Return(mockStatusWriter)
+						mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+						_, err := adapter.EnsureProjectReferenceExists()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(matcher.ActualProjectReference.GetAnnotations()).NotTo(HaveKey("team"))
+						Expect(matcher.ActualProjectReference.GetAnnotations()["region"]).To(Equal("us-east1"))
+					})
+				})
+
+			})
+
+			Context("when the claim requests a service perimeter", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.ServicePerimeterName = "accessPolicies/123456789/servicePerimeters/regulated_workloads"
+				})
+				It("propagates the perimeter name to the ProjectReference", func() {
+					matcher := testStructs.NewProjectReferenceMatcher()
+					mockClient.EXPECT().Create(gomock.Any(), matcher)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					_, err := adapter.EnsureProjectReferenceExists()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(matcher.ActualProjectReference.Spec.ServicePerimeterName).To(Equal(projectClaim.Spec.ServicePerimeterName))
+				})
+			})
+
+			Context("when the claim is already Ready", func() {
+				BeforeEach(func() {
+					projectClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+				})
+				It("recreates the ProjectReference and reverts the claim to PendingProject", func() {
+					matcher := testStructs.NewProjectReferenceMatcher()
+					mockClient.EXPECT().Create(gomock.Any(), matcher)
+					mockClient.EXPECT().Update(gomock.Any(), gomock.Any())
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					res, err := adapter.EnsureProjectReferenceExists()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res.CancelRequest).To(BeTrue())
+					Expect(matcher.ActualProjectReference.Spec.ProjectClaimCRLink.Name).To(Equal(projectClaim.Name))
+					Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusPendingProject))
+				})
+			})
 		})
 
-		Context("when matching ProjectReference exists", func() {
+		Context("when the GCP error budget is exhausted", func() {
 			BeforeEach(func() {
-				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *testStructs.NewProjectReferenceBuilder().GetProjectReference())
+				errorbudget.SetThreshold(1)
+				errorbudget.RecordError()
+				notFound := errors.NewNotFound(schema.GroupResource{}, "FakeProjectReference")
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(notFound)
+			})
+			AfterEach(func() {
+				errorbudget.SetThreshold(30)
+				errorbudget.Reset()
+			})
+			It("delays the ProjectReference creation", func() {
+				res, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueRequest).To(BeTrue())
+				Expect(res.RequeueDelay).To(Equal(errorbudget.BackpressureDelay))
+			})
+		})
+
+		Context("when a matching ProjectReference exists", func() {
+			BeforeEach(func() {
+				matchingReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				matchingReference.Spec.ProjectClaimCRLink = gcpv1alpha1.NamespacedName{Name: projectClaim.Name, Namespace: projectClaim.Namespace}
+				matchingReference.Spec.LegalEntity = projectClaim.Spec.LegalEntity
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+			})
+			It("adopts it without an error", func() {
+				res, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.CancelRequest).To(BeFalse())
+			})
+		})
+
+		Context("when an out-of-band ProjectReference links to a different claim", func() {
+			BeforeEach(func() {
+				foreignReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				foreignReference.Spec.ProjectClaimCRLink = gcpv1alpha1.NamespacedName{Name: "other-claim", Namespace: projectClaim.Namespace}
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *foreignReference)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+			})
+			It("returns ErrMismatchedProjectReference and sets the state to Error", func() {
+				_, err := adapter.EnsureProjectReferenceExists()
+				Expect(er.Is(err, operrors.ErrMismatchedProjectReference)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
 			})
-			It("doesn't return an error", func() {
+		})
+
+		Context("when an out-of-band ProjectReference belongs to a different legal entity", func() {
+			BeforeEach(func() {
+				foreignReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				foreignReference.Spec.ProjectClaimCRLink = gcpv1alpha1.NamespacedName{Name: projectClaim.Name, Namespace: projectClaim.Namespace}
+				foreignReference.Spec.LegalEntity.ID = "foreignLegalEntityID"
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *foreignReference)
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+			})
+			It("returns ErrMismatchedProjectReference and sets the state to Error", func() {
 				_, err := adapter.EnsureProjectReferenceExists()
+				Expect(er.Is(err, operrors.ErrMismatchedProjectReference)).To(BeTrue())
+				Expect(projectClaim.Status.State).To(Equal(gcpv1alpha1.ClaimStatusError))
+			})
+		})
+
+		Context("when the ProjectReference records the claim's UID", func() {
+			BeforeEach(func() {
+				projectClaim.SetUID("fake-uid")
+				matchingReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				matchingReference.SetAnnotations(map[string]string{ClaimUIDAnnotation: "fake-uid"})
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *matchingReference)
+			})
+			It("reuses the ProjectReference", func() {
+				res, err := adapter.EnsureProjectReferenceExists()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueRequest).To(BeFalse())
+			})
+		})
+
+		Context("when the ProjectReference records a different claim UID", func() {
+			BeforeEach(func() {
+				projectClaim.SetUID("new-uid")
+				staleReference := testStructs.NewProjectReferenceBuilder().GetProjectReference()
+				staleReference.SetAnnotations(map[string]string{ClaimUIDAnnotation: "old-uid"})
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *staleReference)
+			})
+			It("deletes the stale ProjectReference and requeues", func() {
+				mockClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1)
+				res, err := adapter.EnsureProjectReferenceExists()
 				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueRequest).To(BeTrue())
 			})
 		})
 	})
@@ -288,6 +1808,7 @@ var _ = Describe("Customresourceadapter", func() {
 					currentState = ""
 				})
 				It("updates the state to Pending", func() {
+					mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
 					mockClient.EXPECT().Status().Times(1).Return(stubStatus{})
 					adapter.EnsureProjectClaimState(requestedState)
 					Expect(projectClaim.Status.State).To(Equal(requestedState))
@@ -315,6 +1836,7 @@ var _ = Describe("Customresourceadapter", func() {
 					currentState = gcpv1alpha1.ClaimStatusPending
 				})
 				It("updates the state to PendingProject", func() {
+					mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
 					mockClient.EXPECT().Status().Times(1).Return(stubStatus{})
 					adapter.EnsureProjectClaimState(requestedState)
 					Expect(projectClaim.Status.State).To(Equal(requestedState))
@@ -322,6 +1844,80 @@ var _ = Describe("Customresourceadapter", func() {
 			})
 		})
 
+		Context("when the state changes", func() {
+			BeforeEach(func() {
+				requestedState = gcpv1alpha1.ClaimStatusPendingProject
+				currentState = gcpv1alpha1.ClaimStatusPending
+			})
+			It("bumps the reconcile count and stamps the coarse phase", func() {
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Times(1).Return(stubStatus{})
+				adapter.EnsureProjectClaimState(requestedState)
+				Expect(projectClaim.Status.ReconcileCount).To(Equal(int64(1)))
+				Expect(projectClaim.Status.Phase).To(Equal(gcpv1alpha1.ClaimPhaseProvisioning))
+			})
+		})
+
+		Context("when the state changes to Error", func() {
+			BeforeEach(func() {
+				requestedState = gcpv1alpha1.ClaimStatusError
+				currentState = gcpv1alpha1.ClaimStatusPending
+			})
+			It("stamps the Failed phase", func() {
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Times(1).Return(stubStatus{})
+				adapter.EnsureProjectClaimState(requestedState)
+				Expect(projectClaim.Status.Phase).To(Equal(gcpv1alpha1.ClaimPhaseFailed))
+			})
+		})
+
+		Context("when the reconcile is a no-op", func() {
+			BeforeEach(func() {
+				requestedState = gcpv1alpha1.ClaimStatusPending
+				currentState = gcpv1alpha1.ClaimStatusPending
+			})
+			It("does not bump the reconcile count", func() {
+				adapter.EnsureProjectClaimState(requestedState)
+				Expect(projectClaim.Status.ReconcileCount).To(Equal(int64(0)))
+			})
+			It("does not touch the state-history annotation", func() {
+				adapter.EnsureProjectClaimState(requestedState)
+				Expect(projectClaim.GetAnnotations()).ToNot(HaveKey(StateHistoryAnnotation))
+			})
+		})
+
+		Context("state-history annotation", func() {
+			BeforeEach(func() {
+				currentState = gcpv1alpha1.ClaimStatusPending
+			})
+
+			It("accumulates transitions as state@timestamp pairs", func() {
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2)
+				mockClient.EXPECT().Status().Times(2).Return(stubStatus{})
+				adapter.EnsureProjectClaimState(gcpv1alpha1.ClaimStatusPendingProject)
+				adapter.EnsureProjectClaimState(gcpv1alpha1.ClaimStatusError)
+				entries := strings.Split(projectClaim.GetAnnotations()[StateHistoryAnnotation], ",")
+				Expect(entries).To(HaveLen(2))
+				Expect(entries[0]).To(HavePrefix("PendingProject@"))
+				Expect(entries[1]).To(HavePrefix("Error@"))
+			})
+
+			It("drops the oldest entries beyond the cap", func() {
+				oldEntries := make([]string, 10)
+				for i := range oldEntries {
+					oldEntries[i] = fmt.Sprintf("Pending@2026-01-01T00:00:%02dZ", i)
+				}
+				projectClaim.SetAnnotations(map[string]string{StateHistoryAnnotation: strings.Join(oldEntries, ",")})
+				mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
+				mockClient.EXPECT().Status().Times(1).Return(stubStatus{})
+				adapter.EnsureProjectClaimState(gcpv1alpha1.ClaimStatusPendingProject)
+				entries := strings.Split(projectClaim.GetAnnotations()[StateHistoryAnnotation], ",")
+				Expect(entries).To(HaveLen(10))
+				Expect(entries[0]).To(Equal(oldEntries[1]))
+				Expect(entries[9]).To(HavePrefix("PendingProject@"))
+			})
+		})
+
 		Context("SetProjectClaimCondition()", func() {
 			var (
 				err           = er.New("fake reconcile")
@@ -343,6 +1939,27 @@ var _ = Describe("Customresourceadapter", func() {
 					adapter.SetProjectClaimCondition(reason, err)
 				})
 			})
+			Context("when the claim has been updated since the last condition", func() {
+				It("stamps the condition with the claim's current generation", func() {
+					projectClaim.Generation = 3
+					conditions := &projectClaim.Status.Conditions
+					*conditions = append(*conditions, gcpv1alpha1.Condition{Type: conditionType})
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					mockConditions.EXPECT().SetCondition(conditions, conditionType, corev1.ConditionTrue, reason, err.Error()).Times(1)
+					adapter.SetProjectClaimCondition(reason, err)
+					Expect(projectClaim.Status.Conditions[0].ObservedGeneration).To(Equal(projectClaim.Generation))
+				})
+			})
+			Context("when the err carries an oversized message", func() {
+				It("truncates the condition message", func() {
+					oversized := er.New(strings.Repeat("x", 5000))
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+					mockConditions.EXPECT().SetCondition(gomock.Any(), conditionType, corev1.ConditionTrue, reason, strings.Repeat("x", 1024)+"...").Times(1)
+					adapter.SetProjectClaimCondition(reason, oversized)
+				})
+			})
 			Context("when the err has been resolved", func() {
 				It("It should update the CRD condition status as resolved", func() {
 					matcher := testStructs.NewProjectClaimMatcher()
@@ -355,6 +1972,107 @@ var _ = Describe("Customresourceadapter", func() {
 				})
 			})
 		})
+
+		Context("EnsureLegalEntityBillingApplied()", func() {
+			billingMapping := corev1.ConfigMap{
+				Data: map[string]string{configmap.LegalEntityBillingKeyPrefix + "fakeLegalEntityID": "fakeBillingAccount2"},
+			}
+
+			Context("when the resolved billing account differs from the requested one", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.BillingAccountID = "fakeBillingAccount1"
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, billingMapping)
+					mockClient.EXPECT().Update(gomock.Any(), gomock.Any())
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingRelinked, corev1.ConditionTrue, "BillingRelinked", gomock.Any()).Times(1)
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				})
+				It("re-points the claim at the resolved account and stops processing", func() {
+					result, err := adapter.EnsureLegalEntityBillingApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeTrue())
+					Expect(projectClaim.Spec.BillingAccountID).To(Equal("fakeBillingAccount2"))
+				})
+			})
+
+			Context("when the claim already matches the central mapping", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.BillingAccountID = "fakeBillingAccount2"
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, billingMapping)
+				})
+				It("does nothing, so a single remap cannot flap", func() {
+					result, err := adapter.EnsureLegalEntityBillingApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeFalse())
+				})
+			})
+
+			Context("when no central mapping is configured for the legal entity", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.BillingAccountID = "fakeBillingAccount1"
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.ConfigMap{})
+				})
+				It("keeps the billing account requested on the claim", func() {
+					result, err := adapter.EnsureLegalEntityBillingApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeFalse())
+					Expect(projectClaim.Spec.BillingAccountID).To(Equal("fakeBillingAccount1"))
+				})
+			})
+
+			Context("when the project is customer supplied", func() {
+				BeforeEach(func() {
+					projectClaim.Spec.CCS = true
+				})
+				It("does not touch billing at all", func() {
+					result, err := adapter.EnsureLegalEntityBillingApplied()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.CancelRequest).To(BeFalse())
+				})
+			})
+		})
+
+		Context("SetReferenceAnnotationTemplates()", func() {
+			It("rejects invalid templates at configuration time", func() {
+				Expect(SetReferenceAnnotationTemplates(map[string]string{"broken": "{{.Spec"})).NotTo(Succeed())
+			})
+		})
+
+		Context("SetLastReconcileTime()", func() {
+			It("stamps the last reconcile time and updates the claim status", func() {
+				matcher := testStructs.NewProjectClaimMatcher()
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), matcher)
+				err := adapter.SetLastReconcileTime()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(matcher.ActualProjectClaim.Status.LastReconcileTime.IsZero()).To(BeFalse())
+			})
+
+			Context("When an operator identity is configured", func() {
+				BeforeEach(func() {
+					SetOperatorIdentity("gcp-project-operator-0")
+				})
+				AfterEach(func() {
+					SetOperatorIdentity("")
+				})
+				It("records the configured identity on the claim", func() {
+					matcher := testStructs.NewProjectClaimMatcher()
+					mockClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), matcher)
+					err := adapter.SetLastReconcileTime()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(matcher.ActualProjectClaim.Status.ReconciledBy).To(Equal("gcp-project-operator-0"))
+				})
+			})
+
+			It("leaves ReconciledBy untouched when no identity is configured", func() {
+				mockClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				err := adapter.SetLastReconcileTime()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(projectClaim.Status.ReconciledBy).To(BeEmpty())
+			})
+		})
 	})
 })
 
@@ -1,12 +1,19 @@
 package projectreference
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha1"
 	"encoding/base64"
+	"encoding/json"
+	goerrors "errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -14,17 +21,22 @@ import (
 	"github.com/openshift/cluster-api/pkg/util"
 	clusterapi "github.com/openshift/cluster-api/pkg/util"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/audit"
 	condition "github.com/openshift/gcp-project-operator/pkg/condition"
 	"github.com/openshift/gcp-project-operator/pkg/configmap"
 	"github.com/openshift/gcp-project-operator/pkg/gcpclient"
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
 	gcputil "github.com/openshift/gcp-project-operator/pkg/util"
 	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iam/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -40,6 +52,32 @@ const (
 	FinalizerName         = "finalizer.gcp.managed.openshift.io"
 )
 
+// ClaimHoldAnnotation holds a claim at PendingProject while set, carrying the
+// reason as its value. External controllers use it to block the operator from
+// marking the claim ready until their own processing is done.
+const ClaimHoldAnnotation = "gcp.managed.openshift.io/hold"
+
+// DeletionOverrideAnnotation overrides the safe-mode deletion block for a single
+// ProjectReference when set to "true", allowing a non-empty project to be deleted.
+const DeletionOverrideAnnotation = "gcp.managed.openshift.io/force-deletion"
+
+// CredentialsDeleteAfterAnnotation records when a retained credentials secret
+// may be deleted, as an RFC3339 timestamp. Secrets carrying it survived their
+// claim's deletion and are removed by the credentials sweep.
+const CredentialsDeleteAfterAnnotation = "gcp.managed.openshift.io/delete-after"
+
+// deletionSafeMode holds the operator-wide safe-mode switch. While enabled,
+// projects that still hold resources are not deleted.
+var deletionSafeMode bool
+
+// SetDeletionSafeMode toggles safe-mode deletion for the ProjectReference controller.
+// In safe mode finalization is blocked while the project still holds resources such
+// as non-default service accounts or running compute instances, unless the
+// deletion-override annotation is set on the ProjectReference.
+func SetDeletionSafeMode(enabled bool) {
+	deletionSafeMode = enabled
+}
+
 // OSDRequiredAPIS is list of API's, required to setup
 // OpenShift cluster. Order is important.
 var OSDRequiredAPIS = []string{
@@ -75,6 +113,17 @@ type ReferenceAdapter struct {
 	kubeClient       client.Client
 	gcpClient        gcpclient.Client
 	conditionManager condition.Conditions
+	// claimMissing is set when the linked ProjectClaim no longer exists,
+	// marking the reference as dangling so it cleans itself up
+	claimMissing bool
+	// recorder emits events for notable adapter actions; optional
+	recorder record.EventRecorder
+}
+
+// SetEventRecorder wires an event recorder into the adapter so operations can
+// surface notable actions as events. The adapter works without one.
+func (r *ReferenceAdapter) SetEventRecorder(recorder record.EventRecorder) {
+	r.recorder = recorder
 }
 
 type ensureAzResult int
@@ -86,10 +135,16 @@ const (
 )
 
 // NewReferenceAdapter creates an adapter to turn what is requested in a ProjectReference into a GCP project and write the output back.
+// A reference whose linked ProjectClaim no longer exists is not an error: it is
+// marked dangling and cleaned up by EnsureDanglingReferenceProcessed.
 func NewReferenceAdapter(projectReference *gcpv1alpha1.ProjectReference, logger logr.Logger, client client.Client, gcpClient gcpclient.Client, manager condition.Conditions) (*ReferenceAdapter, error) {
+	claimMissing := false
 	projectClaim, err := getMatchingClaimLink(projectReference, client)
 	if err != nil {
-		return &ReferenceAdapter{}, err
+		if !k8serrors.IsNotFound(err) {
+			return &ReferenceAdapter{}, err
+		}
+		claimMissing = true
 	}
 	return &ReferenceAdapter{
 		ProjectClaim:     projectClaim,
@@ -98,6 +153,7 @@ func NewReferenceAdapter(projectReference *gcpv1alpha1.ProjectReference, logger
 		kubeClient:       client,
 		gcpClient:        gcpClient,
 		conditionManager: manager,
+		claimMissing:     claimMissing,
 	}, nil
 }
 
@@ -110,6 +166,17 @@ func EnsureProjectClaimReady(r *ReferenceAdapter) (gcputil.OperationResult, erro
 		return gcputil.StopProcessing()
 	}
 
+	// An external controller can hold the claim at PendingProject via the hold
+	// annotation; record why and check again once the hold is released.
+	if reason, held := r.ProjectClaim.GetAnnotations()[ClaimHoldAnnotation]; held {
+		r.logger.V(2).Info("ProjectClaim is held, not marking it ready", "reason", reason)
+		r.conditionManager.SetCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionReconcileHold, corev1.ConditionTrue, "ReconcileHold", reason)
+		if err := r.kubeClient.Status().Update(context.TODO(), r.ProjectClaim); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectClaim status"))
+		}
+		return gcputil.RequeueAfter(30*time.Second, nil)
+	}
+
 	azResult, err := r.ensureClaimAvailabilityZonesSet()
 	if err != nil {
 		return gcputil.RequeueWithError(operrors.Wrap(err, "error ensuring availability zones"))
@@ -125,149 +192,1479 @@ func EnsureProjectClaimReady(r *ReferenceAdapter) (gcputil.OperationResult, erro
 		return gcputil.StopProcessing()
 	}
 
-	if azResult == ensureAzResultNotReady {
-		r.logger.V(2).Info("Compute API not yet fully initialized. Retrying in 30 seconds.")
-		return gcputil.RequeueAfter(30*time.Second, nil)
+	if azResult == ensureAzResultNotReady {
+		r.logger.V(2).Info("Compute API not yet fully initialized. Retrying in 30 seconds.")
+		return gcputil.RequeueAfter(30*time.Second, nil)
+	}
+
+	if holdCondition, found := r.conditionManager.FindCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionReconcileHold); found && holdCondition.Status == corev1.ConditionTrue {
+		r.conditionManager.SetCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionReconcileHold, corev1.ConditionFalse, "HoldReleased", "")
+	}
+
+	r.logger.V(2).Info("Project Ready update matchingClaim to ready")
+	if lastUpdate := r.ProjectReference.Status.LastUpdate; !lastUpdate.IsZero() {
+		localmetrics.ClaimPropagationLagSeconds.Set(time.Since(lastUpdate.Time).Seconds())
+	}
+	if r.ProjectClaim.Status.Timeline.Ready.IsZero() {
+		r.ProjectClaim.Status.Timeline.Ready = metav1.Now()
+		if created := r.ProjectClaim.Status.Timeline.Created; !created.IsZero() {
+			localmetrics.ClaimTimeToReadySeconds.Set(r.ProjectClaim.Status.Timeline.Ready.Sub(created.Time).Seconds())
+		}
+	}
+	r.ProjectClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+	err = r.kubeClient.Status().Update(context.TODO(), r.ProjectClaim)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectClaim status"))
+	}
+	return gcputil.StopProcessing()
+}
+
+func VerifyProjectClaimPending(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectClaim.Status.State != gcpv1alpha1.ClaimStatusPendingProject {
+		return gcputil.RequeueAfter(5*time.Second, nil)
+	}
+	return gcputil.ContinueProcessing()
+}
+
+func EnsureProjectReferenceStatusCreating(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if adapter.ProjectReference.Status.State != "" {
+		return gcputil.ContinueProcessing()
+	}
+	adapter.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusCreating
+	err := adapter.kubeClient.Status().Update(context.TODO(), adapter.ProjectReference)
+	if err != nil {
+		err = operrors.Wrap(err, "error updating ProjectReference status")
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.StopProcessing()
+}
+
+func EnsureProjectID(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if adapter.ProjectReference.Spec.GCPProjectID != "" {
+		return gcputil.ContinueProcessing()
+	}
+	adapter.logger.V(1).Info("Creating ProjectID in ProjectReference CR")
+	err := adapter.UpdateProjectID()
+	if err != nil {
+		err = operrors.Wrap(err, "could not update ProjectID in Project Reference CR")
+		return gcputil.RequeueWithError(err)
+	}
+	return gcputil.StopProcessing()
+}
+
+// defaultProjectCreateTimeout is how long project creation may keep retrying
+// before the ProjectCreateTimeout condition is raised.
+const defaultProjectCreateTimeout = 30 * time.Minute
+
+// projectCreateTimeout bounds the time between the ProjectReference being
+// created and its GCP project becoming ACTIVE. It is distinct from per-call
+// timeouts: creation legitimately takes minutes.
+var projectCreateTimeout = defaultProjectCreateTimeout
+
+// SetProjectCreateTimeout overrides the project creation deadline. A zero or
+// negative timeout restores the default.
+func SetProjectCreateTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		projectCreateTimeout = defaultProjectCreateTimeout
+		return
+	}
+	projectCreateTimeout = timeout
+}
+
+// projectCreatePermission is the IAM permission the operator identity needs
+// on the requested parent to create projects under it.
+const projectCreatePermission = "resourcemanager.projects.create"
+
+// ReasonProjectQuotaExhausted is the condition reason set when GCP refuses
+// project creation because the creation quota is exhausted.
+const ReasonProjectQuotaExhausted = "ProjectQuotaExhausted"
+
+// quotaExhaustedRequeueDelay is how long to wait before retrying project
+// creation after a quota error; retrying sooner cannot succeed.
+const quotaExhaustedRequeueDelay = 30 * time.Minute
+
+// isQuotaExhausted reports whether err is a googleapi error signalling that
+// the project creation quota is exhausted.
+func isQuotaExhausted(err error) bool {
+	ae, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+	if ae.Code == http.StatusTooManyRequests {
+		return true
+	}
+	if ae.Code != http.StatusForbidden {
+		return false
+	}
+	for _, item := range ae.Errors {
+		if item.Reason == "quotaExceeded" || item.Reason == "rateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// Provisioning phase names, used as the phase label on the phase duration
+// histogram and as keys in the PhaseStartTimes/PhaseDurations status maps.
+const (
+	phaseCreate         = "create"
+	phaseBilling        = "billing"
+	phaseEnableAPIs     = "enableAPIs"
+	phaseServiceAccount = "serviceAccount"
+	phaseSecret         = "secret"
+)
+
+// startPhase stamps the phase start time in status on first entry, so a phase
+// spanning several requeues is measured from its true beginning. Phases that
+// already completed are not restarted. The stamp is persisted by the regular
+// status writes of the surrounding reconcile, like the timeline milestones.
+func (r *ReferenceAdapter) startPhase(phase string) {
+	if _, completed := r.ProjectReference.Status.PhaseDurations[phase]; completed {
+		return
+	}
+	if _, started := r.ProjectReference.Status.PhaseStartTimes[phase]; started {
+		return
+	}
+	if r.ProjectReference.Status.PhaseStartTimes == nil {
+		r.ProjectReference.Status.PhaseStartTimes = map[string]metav1.Time{}
+	}
+	r.ProjectReference.Status.PhaseStartTimes[phase] = metav1.Now()
+}
+
+// completePhase observes the duration since the recorded phase start on the
+// phase duration histogram and moves it to the completed durations in status,
+// so re-running the phase's reconcile step does not record it again.
+func (r *ReferenceAdapter) completePhase(phase string) {
+	start, started := r.ProjectReference.Status.PhaseStartTimes[phase]
+	if !started {
+		return
+	}
+	duration := time.Since(start.Time)
+	localmetrics.ProvisioningPhaseDurationSeconds.WithLabelValues(phase).Observe(duration.Seconds())
+	if r.ProjectReference.Status.PhaseDurations == nil {
+		r.ProjectReference.Status.PhaseDurations = map[string]metav1.Duration{}
+	}
+	r.ProjectReference.Status.PhaseDurations[phase] = metav1.Duration{Duration: duration}
+	delete(r.ProjectReference.Status.PhaseStartTimes, phase)
+}
+
+func EnsureProjectCreated(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.isCCS() {
+		return gcputil.ContinueProcessing()
+	}
+	r.startPhase(phaseCreate)
+	configMap, err := r.getConfigMap()
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get ConfigMap: %s Operator Namespace: %s", orgGcpConfigMap, operatorNamespace)))
+	}
+
+	// Pre-flight the requested parent before attempting creation: a missing
+	// grant would otherwise surface as an opaque create failure.
+	if parent := r.ProjectReference.Spec.Parent; parent != nil {
+		granted, err := r.gcpClient.TestParentIamPermissions(string(parent.Type), parent.ID, []string{projectCreatePermission})
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not check permissions on %s %s", parent.Type, parent.ID)))
+		}
+		if canCreate, _ := gcputil.InArray(projectCreatePermission, granted); !canCreate {
+			r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionError, corev1.ConditionTrue, "ParentAccessDenied", fmt.Sprintf("the operator is missing %s on %s %s", projectCreatePermission, parent.Type, parent.ID))
+			if updateErr := r.StatusUpdate(); updateErr != nil {
+				return gcputil.RequeueWithError(updateErr)
+			}
+			return gcputil.RequeueAfter(5*time.Minute, nil)
+		}
+	}
+
+	err = r.createProject(configMap.ParentFolderID)
+	if err != nil {
+		if err == operrors.ErrInactiveProject {
+			r.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusError
+			err := r.kubeClient.Status().Update(context.TODO(), r.ProjectReference)
+			if err != nil {
+				return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectReference status"))
+			}
+			return gcputil.StopProcessing()
+		}
+		if goerrors.Is(err, operrors.ErrProjectQuotaExhausted) {
+			r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionError, corev1.ConditionTrue, ReasonProjectQuotaExhausted, "project creation quota is exhausted; retrying after the quota replenishes")
+			if updateErr := r.StatusUpdate(); updateErr != nil {
+				return gcputil.RequeueWithError(updateErr)
+			}
+			return gcputil.RequeueAfter(quotaExhaustedRequeueDelay, nil)
+		}
+		// Creation legitimately takes a while, but not forever. Raise a
+		// dedicated condition once the creation deadline has passed so the
+		// failure is distinguishable from ordinary retries.
+		if !r.ProjectReference.CreationTimestamp.IsZero() && time.Since(r.ProjectReference.CreationTimestamp.Time) > projectCreateTimeout {
+			r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionError, corev1.ConditionTrue, "ProjectCreateTimeout", fmt.Sprintf("project creation did not complete within %s", projectCreateTimeout))
+			if updateErr := r.StatusUpdate(); updateErr != nil {
+				return gcputil.RequeueWithError(updateErr)
+			}
+		}
+		return gcputil.RequeueWithError(operrors.Wrap(err, "could not create project"))
+	}
+	r.completePhase(phaseCreate)
+
+	r.startPhase(phaseBilling)
+	r.logger.V(1).Info("Configuring Billing APIS")
+	err = r.configureBillingAPI(configMap)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error configuring Billing APIS"))
+	}
+	r.completePhase(phaseBilling)
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureBillingLinked re-links the billing account of a provisioned project if the
+// account requested in the ProjectClaim no longer matches the one linked in GCP.
+func EnsureBillingLinked(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Spec.SkipBillingManagement {
+		return gcputil.ContinueProcessing()
+	}
+	if r.isCCS() || r.ProjectClaim.Spec.BillingAccountID == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady {
+		return gcputil.ContinueProcessing()
+	}
+
+	desiredAccount := strings.TrimSuffix(r.ProjectClaim.Spec.BillingAccountID, "\n")
+	linkedAccount, err := r.gcpClient.GetCloudBillingAccount(r.ProjectReference.Spec.GCPProjectID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error getting linked billing account"))
+	}
+
+	if linkedAccount == fmt.Sprintf("billingAccounts/%s", desiredAccount) {
+		return gcputil.ContinueProcessing()
+	}
+
+	conditions := &r.ProjectReference.Status.Conditions
+	r.logger.Info(fmt.Sprintf("Relinking Billing Account %s", desiredAccount))
+	err = r.gcpClient.CreateCloudBillingAccount(r.ProjectReference.Spec.GCPProjectID, desiredAccount)
+	if err != nil {
+		r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionBillingReady, corev1.ConditionFalse, "BillingAccountLinkFailed", "couldn't link the requested billing account")
+		_ = r.StatusUpdate()
+		return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrBillingLinkFailed, fmt.Sprintf("error relinking billing account: %v", err)))
+	}
+
+	r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionBillingReady, corev1.ConditionTrue, "BillingAccountLinked", "successfully linked the requested billing account")
+	r.publishAudit("LinkBillingAccount")
+	return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+}
+
+// publishAudit emits an audit record for an action performed on the claim's project.
+func (r *ReferenceAdapter) publishAudit(action string) {
+	link := r.ProjectReference.Spec.ProjectClaimCRLink
+	audit.Publish(action, link.Namespace+"/"+link.Name, r.ProjectReference.Spec.GCPProjectID)
+}
+
+func (r *ReferenceAdapter) isCCS() bool {
+	return r.ProjectReference.Spec.CCS
+}
+
+func EnsureProjectConfigured(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	r.startPhase(phaseEnableAPIs)
+	r.logger.V(1).Info("Configuring APIS")
+	err := r.configureAPIS()
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error configuring APIS"))
+	}
+
+	r.logger.V(1).Info("Waiting for API enablement to propagate")
+	result, err := r.VerifyAPIsPropagated()
+	if err != nil || result.RequeueRequest {
+		return result, err
+	}
+	r.completePhase(phaseEnableAPIs)
+
+	r.startPhase(phaseServiceAccount)
+	r.logger.V(1).Info("Configuring Service Account")
+	result, err = r.configureServiceAccount()
+	if err != nil || result.RequeueRequest {
+		return result, err
+	}
+	r.completePhase(phaseServiceAccount)
+
+	r.startPhase(phaseSecret)
+	r.logger.V(1).Info("Creating Credentials")
+	result, err = r.createCredentials()
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error creating credentials"))
+	}
+	r.completePhase(phaseSecret)
+
+	return result, nil
+}
+
+// EnsureDefaultNetworkDeleted removes the auto-mode default network GCP adds
+// to new projects when the claim requests it. It runs after the project is
+// configured because deleting networks needs the Compute API to be enabled.
+func EnsureDefaultNetworkDeleted(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !r.ProjectReference.Spec.DeleteDefaultNetwork {
+		return gcputil.ContinueProcessing()
+	}
+
+	if err := r.gcpClient.DeleteDefaultNetwork(r.ProjectReference.Spec.GCPProjectID); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("error deleting the default network for project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// skipDefaultNetworkConstraint is the org policy constraint preventing GCP
+// from auto-creating the default network in new projects
+const skipDefaultNetworkConstraint = "constraints/compute.skipDefaultNetworkCreation"
+
+// EnsureDefaultNetworkSkipped enforces the compute.skipDefaultNetworkCreation
+// org policy on the project and removes the default network that was already
+// auto-created with it, for claims whose security baseline forbids it.
+// Completion is reported via the DefaultNetworkSkipped condition.
+func EnsureDefaultNetworkSkipped(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !r.ProjectReference.Spec.SkipDefaultNetwork {
+		return gcputil.ContinueProcessing()
+	}
+	if skipped, found := r.conditionManager.FindCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionDefaultNetworkSkipped); found && skipped.Status == corev1.ConditionTrue {
+		return gcputil.ContinueProcessing()
+	}
+
+	projectID := r.ProjectReference.Spec.GCPProjectID
+	if err := r.gcpClient.SetProjectBooleanOrgPolicy(projectID, skipDefaultNetworkConstraint, true); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not enforce %s for project %s", skipDefaultNetworkConstraint, projectID)))
+	}
+	// The policy only affects projects created after it took effect; remove
+	// the network that was auto-created with this one.
+	if err := r.gcpClient.DeleteDefaultNetwork(projectID); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("error deleting the default network for project %s", projectID)))
+	}
+
+	r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionDefaultNetworkSkipped, corev1.ConditionTrue, "DefaultNetworkSkipped", fmt.Sprintf("default network creation is disabled for project %s", projectID))
+	return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+}
+
+// baselineInternalSourceRanges are the source ranges of the baseline rule
+// allowing internal traffic. Operators override them via SetBaselineFirewallSourceRanges.
+var baselineInternalSourceRanges = []string{"10.0.0.0/8"}
+
+// baselineIAPSourceRanges are the source ranges of the baseline rule allowing
+// SSH through Identity-Aware Proxy; the default is GCP's published IAP range.
+var baselineIAPSourceRanges = []string{"35.235.240.0/20"}
+
+// SetBaselineFirewallSourceRanges overrides the source ranges used by the
+// baseline firewall rules at operator level. Empty slices restore the defaults.
+func SetBaselineFirewallSourceRanges(internal, iap []string) {
+	if len(internal) == 0 {
+		internal = []string{"10.0.0.0/8"}
+	}
+	if len(iap) == 0 {
+		iap = []string{"35.235.240.0/20"}
+	}
+	baselineInternalSourceRanges = internal
+	baselineIAPSourceRanges = iap
+}
+
+// EnsureBaselineFirewallRules creates the standard baseline firewall rule set
+// (allow internal traffic, allow SSH through IAP) on the project's network when
+// the claim requests it. It runs after the project is configured because
+// creating firewall rules needs the Compute API to be enabled.
+func EnsureBaselineFirewallRules(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Spec.Network == nil || !r.ProjectReference.Spec.Network.BaselineFirewallRules {
+		return gcputil.ContinueProcessing()
+	}
+
+	network := r.ProjectReference.Spec.Network.Name
+	if network == "" {
+		network = "default"
+	}
+	for _, rule := range baselineFirewallRules(r.ProjectReference.Spec.GCPProjectID, network) {
+		if err := r.gcpClient.CreateFirewallRule(r.ProjectReference.Spec.GCPProjectID, rule); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("error creating baseline firewall rule %s", rule.Name)))
+		}
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// baselineFirewallRules returns the standard rule set created on the given network
+func baselineFirewallRules(projectID, network string) []*compute.Firewall {
+	networkLink := fmt.Sprintf("projects/%s/global/networks/%s", projectID, network)
+	return []*compute.Firewall{
+		{
+			Name:         "allow-internal",
+			Network:      networkLink,
+			Direction:    "INGRESS",
+			SourceRanges: baselineInternalSourceRanges,
+			Allowed: []*compute.FirewallAllowed{
+				{IPProtocol: "tcp"},
+				{IPProtocol: "udp"},
+				{IPProtocol: "icmp"},
+			},
+		},
+		{
+			Name:         "allow-iap-ssh",
+			Network:      networkLink,
+			Direction:    "INGRESS",
+			SourceRanges: baselineIAPSourceRanges,
+			Allowed: []*compute.FirewallAllowed{
+				{IPProtocol: "tcp", Ports: []string{"22"}},
+			},
+		},
+	}
+}
+
+// resourceLocationsConstraint is the org policy constraint restricting where resources can be created
+const resourceLocationsConstraint = "constraints/gcp.resourceLocations"
+
+// supportedResourceLocations are the values accepted in Spec.ResourceLocationRestriction:
+// the multi-region value groups and the regions supported by the operator
+var supportedResourceLocations = map[string]bool{
+	"in:asia-locations": true,
+	"in:eu-locations":   true,
+	"in:us-locations":   true,
+
+	"asia-east1":      true,
+	"asia-east2":      true,
+	"asia-northeast1": true,
+	"asia-southeast1": true,
+	"europe-west1":    true,
+	"europe-west2":    true,
+	"europe-west4":    true,
+	"us-central1":     true,
+	"us-east1":        true,
+	"us-east4":        true,
+	"us-west1":        true,
+	"us-west2":        true,
+}
+
+// EnsureResourceLocationRestricted applies the gcp.resourceLocations org policy constraint
+// scoped to the project when the spec restricts resource locations.
+// Unknown locations are rejected before any API call is made.
+func EnsureResourceLocationRestricted(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if len(r.ProjectReference.Spec.ResourceLocationRestriction) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	for _, location := range r.ProjectReference.Spec.ResourceLocationRestriction {
+		if !supportedResourceLocations[location] {
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidResourceLocation, fmt.Sprintf("unsupported resource location %s", location)))
+		}
+	}
+
+	r.logger.V(1).Info("Restricting Resource Locations")
+	err := r.gcpClient.SetProjectOrgPolicy(r.ProjectReference.Spec.GCPProjectID, resourceLocationsConstraint, r.ProjectReference.Spec.ResourceLocationRestriction)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not restrict resource locations for project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureProjectNumberPropagated records the numeric GCP project number on the
+// reference once the project is created and mirrors it to the claim status,
+// for integrations that need the number instead of the project ID (such as
+// default service account emails). A project that is not visible yet is not an
+// error; the number is picked up on a later reconcile.
+func EnsureProjectNumberPropagated(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	if r.ProjectReference.Status.GCPProjectNumber == 0 {
+		project, err := r.gcpClient.GetProject(r.ProjectReference.Spec.GCPProjectID)
+		if err != nil || project.ProjectNumber == 0 {
+			// The project number may not be queryable right after creation;
+			// pick it up on the next reconcile instead of failing provisioning
+			r.logger.V(1).Info("GCP project number not available yet")
+			return gcputil.ContinueProcessing()
+		}
+		r.ProjectReference.Status.GCPProjectNumber = project.ProjectNumber
+		if err := r.StatusUpdate(); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+	}
+
+	if !r.claimMissing && r.ProjectClaim.Status.GCPProjectNumber != r.ProjectReference.Status.GCPProjectNumber {
+		r.ProjectClaim.Status.GCPProjectNumber = r.ProjectReference.Status.GCPProjectNumber
+		return gcputil.RequeueOnErrorOrContinue(gcputil.UpdateStatus(r.kubeClient, r.ProjectClaim))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureServiceAccountEmailSurfaced records the email of the service account
+// created for the project on the reference status and mirrors it to the claim
+// status, so consumers do not have to read the email out of the credential
+// secret. An account that is not visible yet is not an error; the email is
+// picked up on a later reconcile.
+func EnsureServiceAccountEmailSurfaced(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	if r.ProjectReference.Status.ServiceAccountEmail == "" {
+		accountID, err := r.serviceAccountID()
+		if err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+		serviceAccount, err := r.gcpClient.GetServiceAccount(accountID)
+		if err != nil {
+			r.logger.V(1).Info("Service account not available yet")
+			return gcputil.ContinueProcessing()
+		}
+		r.ProjectReference.Status.ServiceAccountEmail = serviceAccount.Email
+		if err := r.StatusUpdate(); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+	}
+
+	if !r.claimMissing && r.ProjectClaim.Status.ServiceAccountEmail != r.ProjectReference.Status.ServiceAccountEmail {
+		r.ProjectClaim.Status.ServiceAccountEmail = r.ProjectReference.Status.ServiceAccountEmail
+		return gcputil.RequeueOnErrorOrContinue(gcputil.UpdateStatus(r.kubeClient, r.ProjectClaim))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureProjectParent moves an already-provisioned project under the parent
+// requested in the spec when they diverge, e.g. after an org restructure.
+// Progress is surfaced through the ProjectMoving condition; the claim stays
+// Ready during the move and only the condition reflects it. The operation runs
+// on the ready path, before the Ready short-circuit, so parent changes on a
+// Ready claim still take effect.
+func EnsureProjectParent(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if r.isCCS() || r.ProjectReference.Spec.Parent == nil {
+		return gcputil.ContinueProcessing()
+	}
+
+	projectID := r.ProjectReference.Spec.GCPProjectID
+	project, err := r.gcpClient.GetProject(projectID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get project %s to check its parent", projectID)))
+	}
+
+	parent := r.ProjectReference.Spec.Parent
+	conditions := &r.ProjectReference.Status.Conditions
+	if project.Parent != nil && project.Parent.Type == string(parent.Type) && project.Parent.Id == parent.ID {
+		// The project is already where it should be; clear a previously reported move
+		if moving, found := r.conditionManager.FindCondition(conditions, gcpv1alpha1.ConditionProjectMoving); found && moving.Status == corev1.ConditionTrue {
+			r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionProjectMoving, corev1.ConditionFalse, "ProjectMoveComplete", "")
+			if err := r.StatusUpdate(); err != nil {
+				return gcputil.RequeueWithError(err)
+			}
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	r.logger.Info(fmt.Sprintf("Moving project %s under %s %s", projectID, parent.Type, parent.ID))
+	r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionProjectMoving, corev1.ConditionTrue, "ProjectMoveInProgress", fmt.Sprintf("moving the project under %s %s", parent.Type, parent.ID))
+	if err := r.StatusUpdate(); err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	err = r.gcpClient.MoveProject(projectID, string(parent.Type), parent.ID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not move project %s under %s %s", projectID, parent.Type, parent.ID)))
+	}
+
+	// Verify the move on the next pass before clearing the condition
+	return gcputil.RequeueAfter(30*time.Second, nil)
+}
+
+// trustedImageProjectsConstraint is the org policy constraint restricting where VM images can come from
+const trustedImageProjectsConstraint = "constraints/compute.trustedImageProjects"
+
+// trustedImageProjectRegexp matches the projects/<id> form expected by the constraint
+var trustedImageProjectRegexp = regexp.MustCompile(`^projects/[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// EnsureTrustedImageProjects applies the compute.trustedImageProjects org policy constraint
+// scoped to the project when the spec lists trusted image projects.
+// Malformed entries are rejected before any API call is made.
+func EnsureTrustedImageProjects(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if len(r.ProjectReference.Spec.TrustedImageProjects) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	for _, project := range r.ProjectReference.Spec.TrustedImageProjects {
+		if !trustedImageProjectRegexp.MatchString(project) {
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidTrustedImageProject, fmt.Sprintf("trusted image project %s must have the form projects/<id>", project)))
+		}
+	}
+
+	r.logger.V(1).Info("Restricting Trusted Image Projects")
+	err := r.gcpClient.SetProjectOrgPolicy(r.ProjectReference.Spec.GCPProjectID, trustedImageProjectsConstraint, r.ProjectReference.Spec.TrustedImageProjects)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not restrict trusted image projects for project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// requireOSLoginConstraint is the org policy constraint forcing VM access through OS Login
+const requireOSLoginConstraint = "constraints/compute.requireOsLogin"
+
+// EnsureOSLoginRequired enforces the compute.requireOsLogin org policy constraint
+// scoped to the project when the spec asks for it.
+func EnsureOSLoginRequired(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !r.ProjectReference.Spec.RequireOSLogin {
+		return gcputil.ContinueProcessing()
+	}
+
+	r.logger.V(1).Info("Enforcing OS Login")
+	err := r.gcpClient.SetProjectBooleanOrgPolicy(r.ProjectReference.Spec.GCPProjectID, requireOSLoginConstraint, true)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not enforce OS Login for project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// disableSerialPortAccessConstraint is the org policy constraint blocking VM serial port access
+const disableSerialPortAccessConstraint = "constraints/compute.disableSerialPortAccess"
+
+// EnsureSerialPortAccessDisabled enforces the compute.disableSerialPortAccess
+// org policy constraint scoped to the project when the spec asks for it.
+func EnsureSerialPortAccessDisabled(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !r.ProjectReference.Spec.DisableSerialPortAccess {
+		return gcputil.ContinueProcessing()
+	}
+
+	r.logger.V(1).Info("Disabling serial port access")
+	err := r.gcpClient.SetProjectBooleanOrgPolicy(r.ProjectReference.Spec.GCPProjectID, disableSerialPortAccessConstraint, true)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not disable serial port access for project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// computeDefaultRegionMetadataKey and computeDefaultZoneMetadataKey are the
+// project metadata keys gcloud reads its compute defaults from.
+const (
+	computeDefaultRegionMetadataKey = "google-compute-default-region"
+	computeDefaultZoneMetadataKey   = "google-compute-default-zone"
+)
+
+// EnsureComputeDefaultMetadata sets the project metadata gcloud reads its
+// default compute region and zone from. It runs after EnsureProjectConfigured
+// so the Compute API is already enabled. A zone outside the requested region
+// is a spec error, not a transient failure.
+func EnsureComputeDefaultMetadata(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	region := r.ProjectReference.Spec.DefaultComputeRegion
+	zone := r.ProjectReference.Spec.DefaultComputeZone
+	if region == "" && zone == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if zone != "" && !strings.HasPrefix(zone, region+"-") {
+		return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidComputeZone, fmt.Sprintf("default compute zone %s does not lie within region %s", zone, region)))
+	}
+
+	metadata := map[string]string{}
+	if region != "" {
+		metadata[computeDefaultRegionMetadataKey] = region
+	}
+	if zone != "" {
+		metadata[computeDefaultZoneMetadataKey] = zone
+	}
+	r.logger.V(1).Info("Applying default compute region and zone metadata")
+	err := r.gcpClient.SetComputeProjectMetadata(r.ProjectReference.Spec.GCPProjectID, metadata)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not set default compute metadata on project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// operatorImpersonationMember is the operator's own identity granted token
+// creator access on created service accounts; empty disables the binding step.
+var operatorImpersonationMember string
+
+// SetOperatorImpersonationMember sets the IAM member bound as token creator on
+// each project service account, enabling keyless operation.
+func SetOperatorImpersonationMember(member string) {
+	operatorImpersonationMember = member
+}
+
+// EnsureOperatorImpersonation grants the operator's identity
+// roles/iam.serviceAccountTokenCreator on the project service account, so the
+// operator can mint tokens for it instead of holding long-lived keys. The step
+// is a no-op unless an impersonation member is configured.
+func EnsureOperatorImpersonation(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if operatorImpersonationMember == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	serviceAccount, err := r.gcpClient.GetServiceAccount(accountID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "could not get the project service account for the impersonation binding"))
+	}
+
+	err = r.gcpClient.BindServiceAccountTokenCreator(r.ProjectReference.Spec.GCPProjectID, serviceAccount.Email, operatorImpersonationMember)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not bind %s as token creator on %s", operatorImpersonationMember, serviceAccount.Email)))
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureNotificationChannels creates the monitoring notification channels
+// requested in the spec and records their resource names on the status, so
+// budgets and alerts can be wired to them later.
+func EnsureNotificationChannels(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if len(r.ProjectReference.Spec.NotificationChannels) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	channelIDs := make([]string, 0, len(r.ProjectReference.Spec.NotificationChannels))
+	for _, channel := range r.ProjectReference.Spec.NotificationChannels {
+		channelID, err := r.gcpClient.CreateNotificationChannel(r.ProjectReference.Spec.GCPProjectID, channel.Type, channel.DisplayName, channel.Labels)
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create notification channel %s", channel.DisplayName)))
+		}
+		channelIDs = append(channelIDs, channelID)
+	}
+
+	if !reflect.DeepEqual(r.ProjectReference.Status.NotificationChannelIDs, channelIDs) {
+		r.ProjectReference.Status.NotificationChannelIDs = channelIDs
+		if err := r.StatusUpdate(); err != nil {
+			return gcputil.RequeueWithError(err)
+		}
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// emailRegexp is a lightweight sanity check for essential contact addresses
+var emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// allowedContactCategories are the notification categories accepted by the Essential Contacts API
+var allowedContactCategories = map[string]bool{
+	"ALL":             true,
+	"BILLING":         true,
+	"LEGAL":           true,
+	"PRODUCT_UPDATES": true,
+	"SECURITY":        true,
+	"SUSPENSION":      true,
+	"TECHNICAL":       true,
+}
+
+// EnsureProjectDisplayName syncs the requested display name onto the GCP project,
+// defaulting to the claim name when the spec does not set one. Customer projects
+// (CCS) are left untouched. The operation runs on the ready path, before the
+// Ready short-circuit, so a display name edit on a Ready claim still reaches GCP.
+func EnsureProjectDisplayName(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" || r.claimMissing {
+		return gcputil.ContinueProcessing()
+	}
+	if r.isCCS() {
+		return gcputil.ContinueProcessing()
+	}
+	displayName := r.ProjectReference.Spec.DisplayName
+	if displayName == "" {
+		displayName = r.ProjectClaim.GetName()
+	}
+	err := r.gcpClient.UpdateProjectDisplayName(r.ProjectReference.Spec.GCPProjectID, displayName)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not update the display name of project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// LastAppliedLabelsAnnotation records a hash of the labels last applied to the
+// GCP project, so unchanged labels do not trigger another update API call.
+const LastAppliedLabelsAnnotation = "gcp.managed.openshift.io/last-applied-labels"
+
+// LastAppliedLabelKeysAnnotation records the label keys last applied to the
+// GCP project, so keys dropped from the spec can be removed from the project
+// without touching labels the operator never managed.
+const LastAppliedLabelKeysAnnotation = "gcp.managed.openshift.io/last-applied-label-keys"
+
+// labelsHash returns a stable content hash of the label map, independent of
+// map iteration order.
+func labelsHash(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := sha1.New()
+	for _, key := range keys {
+		fmt.Fprintf(hasher, "%s=%s;", key, labels[key])
+	}
+	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))
+}
+
+// EnsureProjectLabels syncs the requested labels onto the GCP project. The hash
+// of the last applied labels is kept in an annotation so reconciles with
+// unchanged labels skip the GCP API entirely. Keys that were applied earlier
+// but have since been dropped from the spec are removed from the project;
+// labels the operator never managed are left alone. Customer projects (CCS)
+// are left untouched. The operation runs on the ready path, before the Ready
+// short-circuit, so label edits on a Ready claim still propagate.
+func EnsureProjectLabels(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if r.isCCS() {
+		return gcputil.ContinueProcessing()
+	}
+
+	appliedKeys := []string{}
+	if raw := r.ProjectReference.GetAnnotations()[LastAppliedLabelKeysAnnotation]; raw != "" {
+		appliedKeys = strings.Split(raw, ",")
+	}
+	if len(r.ProjectReference.Spec.Labels) == 0 && len(appliedKeys) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	hash := labelsHash(r.ProjectReference.Spec.Labels)
+	if r.ProjectReference.GetAnnotations()[LastAppliedLabelsAnnotation] == hash {
+		return gcputil.ContinueProcessing()
+	}
+
+	removals := []string{}
+	for _, key := range appliedKeys {
+		if _, ok := r.ProjectReference.Spec.Labels[key]; !ok {
+			removals = append(removals, key)
+		}
+	}
+
+	r.logger.V(1).Info("Applying project labels")
+	err := r.gcpClient.SetProjectLabels(r.ProjectReference.Spec.GCPProjectID, r.ProjectReference.Spec.Labels, removals)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not set labels on project %s", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	annotations := r.ProjectReference.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[LastAppliedLabelsAnnotation] = hash
+	keys := make([]string, 0, len(r.ProjectReference.Spec.Labels))
+	for key := range r.ProjectReference.Spec.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if len(keys) == 0 {
+		delete(annotations, LastAppliedLabelKeysAnnotation)
+	} else {
+		annotations[LastAppliedLabelKeysAnnotation] = strings.Join(keys, ",")
+	}
+	r.ProjectReference.SetAnnotations(annotations)
+	return gcputil.RequeueOnErrorOrContinue(r.kubeClient.Update(context.TODO(), r.ProjectReference))
+}
+
+// EnsureProjectTagsBound binds the requested resource manager tag values to the
+// project. Tags are referenced by short names in the spec and must resolve to
+// existing tag definitions; a tag that does not resolve is a spec error, not a
+// transient failure.
+func EnsureProjectTagsBound(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if len(r.ProjectReference.Spec.Tags) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	for key, value := range r.ProjectReference.Spec.Tags {
+		tagValueName, err := r.gcpClient.LookupTagValue(key, value)
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidTag, fmt.Sprintf("tag %s=%s does not resolve to an existing tag value: %v", key, value, err)))
+		}
+		err = r.gcpClient.CreateTagBinding(r.ProjectReference.Spec.GCPProjectID, tagValueName)
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not bind tag %s=%s to project %s", key, value, r.ProjectReference.Spec.GCPProjectID)))
+		}
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureEssentialContacts creates the essential contacts requested in the spec on the project.
+// Contacts with an invalid email address or an unknown notification category are
+// rejected before any API call is made.
+func EnsureEssentialContacts(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if len(r.ProjectReference.Spec.EssentialContacts) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	for _, contact := range r.ProjectReference.Spec.EssentialContacts {
+		if !emailRegexp.MatchString(contact.Email) {
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidEssentialContact, fmt.Sprintf("invalid essential contact email %s", contact.Email)))
+		}
+		for _, category := range contact.Categories {
+			if !allowedContactCategories[category] {
+				return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidEssentialContact, fmt.Sprintf("invalid essential contact category %s for %s", category, contact.Email)))
+			}
+		}
+	}
+
+	conditions := &r.ProjectReference.Status.Conditions
+	r.logger.V(1).Info("Configuring Essential Contacts")
+	for _, contact := range r.ProjectReference.Spec.EssentialContacts {
+		err := r.gcpClient.CreateEssentialContact(r.ProjectReference.Spec.GCPProjectID, contact.Email, contact.Categories)
+		if err != nil {
+			r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionContactsReady, corev1.ConditionFalse, "EssentialContactsConfigFailed", fmt.Sprintf("couldn't configure essential contact %s", contact.Email))
+			_ = r.StatusUpdate()
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create essential contact %s", contact.Email)))
+		}
+	}
+
+	// Only mark the condition resolved if an earlier pass reported a failure
+	for i := range *conditions {
+		if (*conditions)[i].Type == gcpv1alpha1.ConditionContactsReady && (*conditions)[i].Status == corev1.ConditionFalse {
+			r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionContactsReady, corev1.ConditionTrue, "EssentialContactsConfigured", "successfully configured the requested essential contacts")
+			return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+		}
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// kmsAPI must be enabled on the project before keyrings and keys can be created
+const kmsAPI = "cloudkms.googleapis.com"
+
+// EnsureKMSConfigured enables the Cloud KMS API and creates the keyrings and
+// keys requested in the spec. Keyrings and keys that already exist are left as
+// is, so repeated reconciles are safe.
+func EnsureKMSConfigured(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	keyRings := r.ProjectReference.Spec.KMSKeyRings
+	if len(keyRings) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	projectID := r.ProjectReference.Spec.GCPProjectID
+	r.logger.V(1).Info("Configuring Cloud KMS")
+	if err := r.gcpClient.EnableAPI(projectID, kmsAPI); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("error enabling %s api for project %s", kmsAPI, projectID)))
+	}
+
+	for _, keyRing := range keyRings {
+		if err := r.gcpClient.CreateKMSKeyRing(projectID, keyRing.Location, keyRing.Name); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create KMS keyring %s in %s", keyRing.Name, keyRing.Location)))
+		}
+		var rotationPeriod time.Duration
+		if keyRing.RotationPeriod != nil {
+			rotationPeriod = keyRing.RotationPeriod.Duration
+		}
+		for _, key := range keyRing.KeyNames {
+			if err := r.gcpClient.CreateKMSCryptoKey(projectID, keyRing.Location, keyRing.Name, key, rotationPeriod); err != nil {
+				return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create KMS key %s in keyring %s", key, keyRing.Name)))
+			}
+		}
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureWorkloadIdentityConfigured provisions the workload identity pool and provider
+// requested in the spec and allows identities from the pool to impersonate the
+// operator-created service account via roles/iam.workloadIdentityUser.
+func EnsureWorkloadIdentityConfigured(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	workloadIdentity := r.ProjectReference.Spec.WorkloadIdentity
+	if workloadIdentity == nil {
+		return gcputil.ContinueProcessing()
+	}
+
+	projectID := r.ProjectReference.Spec.GCPProjectID
+	r.logger.V(1).Info("Configuring Workload Identity Federation")
+	if err := r.gcpClient.CreateWorkloadIdentityPool(projectID, workloadIdentity.PoolID); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create workload identity pool %s", workloadIdentity.PoolID)))
+	}
+
+	provider := workloadIdentity.Provider
+	if err := r.gcpClient.CreateWorkloadIdentityProvider(projectID, workloadIdentity.PoolID, provider.ID, provider.IssuerURI, provider.AllowedAudiences); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create workload identity provider %s", provider.ID)))
+	}
+
+	project, err := r.gcpClient.GetProject(projectID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get project %s to bind the workload identity pool", projectID)))
+	}
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	serviceAccount, err := r.gcpClient.GetServiceAccount(accountID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get service account for %s to bind the workload identity pool", accountID)))
+	}
+
+	member := fmt.Sprintf("principalSet://iam.googleapis.com/projects/%d/locations/global/workloadIdentityPools/%s/*", project.ProjectNumber, workloadIdentity.PoolID)
+	if err := r.gcpClient.BindWorkloadIdentityUser(projectID, serviceAccount.Email, member); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not bind workload identity pool %s to service account %s", workloadIdentity.PoolID, serviceAccount.Email)))
+	}
+
+	// Keyless claims rely on the binding instead of a credential secret, so
+	// report its success in a condition consumers can gate on.
+	conditions := &r.ProjectReference.Status.Conditions
+	if wiCondition, found := r.conditionManager.FindCondition(conditions, gcpv1alpha1.ConditionWorkloadIdentityReady); !found || wiCondition.Status != corev1.ConditionTrue {
+		r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionWorkloadIdentityReady, corev1.ConditionTrue, "WorkloadIdentityConfigured", fmt.Sprintf("workload identity pool %s bound to %s", workloadIdentity.PoolID, serviceAccount.Email))
+		return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+	}
+
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureServicePerimeterJoined adds the project to the VPC Service Controls
+// perimeter requested in the spec and reports membership in the PerimeterJoined
+// condition. A join failure requeues, so the reference does not reach Ready
+// until membership is confirmed.
+func EnsureServicePerimeterJoined(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	perimeterName := r.ProjectReference.Spec.ServicePerimeterName
+	if perimeterName == "" {
+		return gcputil.ContinueProcessing()
+	}
+
+	conditions := &r.ProjectReference.Status.Conditions
+	project, err := r.gcpClient.GetProject(r.ProjectReference.Spec.GCPProjectID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not look up project %s for perimeter membership", r.ProjectReference.Spec.GCPProjectID)))
+	}
+
+	r.logger.V(1).Info("Ensuring service perimeter membership")
+	err = r.gcpClient.AddProjectToServicePerimeter(perimeterName, project.ProjectNumber)
+	if err != nil {
+		r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionPerimeterJoined, corev1.ConditionFalse, "PerimeterJoinFailed", fmt.Sprintf("couldn't join service perimeter %s", perimeterName))
+		_ = r.StatusUpdate()
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not add project %s to service perimeter %s", r.ProjectReference.Spec.GCPProjectID, perimeterName)))
+	}
+
+	r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionPerimeterJoined, corev1.ConditionTrue, "PerimeterJoined", fmt.Sprintf("project is a member of service perimeter %s", perimeterName))
+	return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+}
+
+func EnsureStateReady(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady {
+		r.logger.V(1).Info("Setting Status on projectReference")
+		r.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusReady
+		return gcputil.RequeueOnErrorOrStop(r.kubeClient.Status().Update(context.TODO(), r.ProjectReference))
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// iamDriftRepairEnabled allows EnsureIAMPolicyHealthy to re-apply the managed
+// bindings when drift is detected, instead of only reporting it.
+var iamDriftRepairEnabled bool
+
+// SetIAMDriftRepair toggles automatic re-application of the managed IAM
+// bindings when EnsureIAMPolicyHealthy detects drift.
+func SetIAMDriftRepair(enabled bool) {
+	iamDriftRepairEnabled = enabled
+}
+
+// EnsureIAMPolicyHealthy compares the project's actual IAM policy against the
+// operator-managed bindings once the reference is Ready and reports
+// out-of-band changes via the IAMDrift condition. When drift repair is
+// enabled the managed bindings are re-applied as well.
+func EnsureIAMPolicyHealthy(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" || r.claimMissing {
+		return gcputil.ContinueProcessing()
+	}
+
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	serviceAccountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountID, r.ProjectReference.Spec.GCPProjectID)
+
+	policy, err := r.gcpClient.GetIamPolicy(r.ProjectReference.Spec.GCPProjectID)
+	if err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "could not get the IAM policy for drift detection"))
+	}
+
+	drift := describeIAMDrift(policy, OSDRequiredRoles, serviceAccountEmail)
+	conditions := &r.ProjectReference.Status.Conditions
+	if len(drift) == 0 {
+		// Read-only loop instead of FindCondition, which appends a stub
+		// condition when none exists and would dirty the status every reconcile
+		for _, driftCondition := range *conditions {
+			if driftCondition.Type == gcpv1alpha1.ConditionIAMDrift && driftCondition.Status == corev1.ConditionTrue {
+				r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionIAMDrift, corev1.ConditionFalse, "IAMDriftResolved", "managed IAM bindings match the desired state again")
+				return gcputil.RequeueOnErrorOrContinue(r.StatusUpdate())
+			}
+		}
+		return gcputil.ContinueProcessing()
+	}
+
+	message := strings.Join(drift, "; ")
+	r.logger.Info("Detected IAM policy drift", "Drift", message)
+	r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionIAMDrift, corev1.ConditionTrue, "IAMDrift", message)
+	if err := r.StatusUpdate(); err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	if iamDriftRepairEnabled {
+		if err := r.SetIAMPolicy(serviceAccountEmail); err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "could not repair drifted IAM bindings"))
+		}
+	}
+	return gcputil.ContinueProcessing()
+}
+
+// EnsureServiceAccountHealthy verifies on the ready path that the operator's
+// service account still exists. A service account deleted out-of-band leaves
+// the credentials secret useless while the claim still looks Ready, so the
+// account is recreated along with a fresh key and credentials secret.
+func EnsureServiceAccountHealthy(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" || r.claimMissing {
+		return gcputil.ContinueProcessing()
+	}
+
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	_, err = r.gcpClient.GetServiceAccount(accountID)
+	if err == nil {
+		return gcputil.ContinueProcessing()
+	}
+	if !matchesNotFoundError(err) {
+		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not verify service account %s", accountID)))
+	}
+
+	r.logger.Info("Service account was deleted out-of-band, recreating it", "ServiceAccount", accountID)
+	result, err := r.configureServiceAccount()
+	if err != nil || result.RequeueRequest || result.CancelRequest {
+		return result, err
+	}
+
+	if r.ProjectReference.Spec.SkipCredentialsSecret {
+		return gcputil.ContinueProcessing()
+	}
+
+	// The old secret holds a key of the deleted account; remove it so
+	// createCredentials writes a fresh key instead of trusting the stale one.
+	if err := r.deleteCredentials(); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "could not delete the stale credentials secret"))
+	}
+	return r.createCredentials()
+}
+
+// describeIAMDrift lists the differences between the policy's managed role
+// bindings and the desired state, as human-readable messages.
+func describeIAMDrift(policy *cloudresourcemanager.Policy, managedRoles []string, serviceAccountEmail string) []string {
+	member := fmt.Sprintf("serviceAccount:%s", serviceAccountEmail)
+	var drift []string
+	for _, role := range managedRoles {
+		var binding *cloudresourcemanager.Binding
+		for _, candidate := range policy.Bindings {
+			if candidate.Role == role {
+				binding = candidate
+				break
+			}
+		}
+		if binding == nil {
+			drift = append(drift, fmt.Sprintf("managed binding %s is missing", role))
+			continue
+		}
+		if exists, _ := gcputil.InArray(member, binding.Members); !exists {
+			drift = append(drift, fmt.Sprintf("%s was removed from managed binding %s", member, role))
+		}
+		for _, bindingMember := range binding.Members {
+			if bindingMember != member {
+				drift = append(drift, fmt.Sprintf("unmanaged member %s added to managed binding %s", bindingMember, role))
+			}
+		}
+	}
+	return drift
+}
+
+func getMatchingClaimLink(projectReference *gcpv1alpha1.ProjectReference, client client.Client) (*gcpv1alpha1.ProjectClaim, error) {
+	projectClaim := &gcpv1alpha1.ProjectClaim{}
+	err := client.Get(context.TODO(), types.NamespacedName{Name: projectReference.Spec.ProjectClaimCRLink.Name, Namespace: projectReference.Spec.ProjectClaimCRLink.Namespace}, projectClaim)
+	if err != nil {
+		return &gcpv1alpha1.ProjectClaim{}, err
+
+	}
+	return projectClaim, nil
+}
+
+// IDGenerator produces the GCP project ID for a claim. Implementations can
+// replace the default random generator with deterministic or externally
+// sourced IDs, e.g. from a CMDB.
+type IDGenerator interface {
+	GenerateProjectID(projectClaim *gcpv1alpha1.ProjectClaim) (string, error)
+}
+
+// RandomIDGenerator is the default IDGenerator, deriving a random unique project ID.
+type RandomIDGenerator struct{}
+
+// GenerateProjectID returns a random project ID, ignoring the claim.
+func (RandomIDGenerator) GenerateProjectID(_ *gcpv1alpha1.ProjectClaim) (string, error) {
+	return GenerateProjectID()
+}
+
+// idGenerator holds the project ID generation strategy for new ProjectReferences.
+var idGenerator IDGenerator = RandomIDGenerator{}
+
+// SetIDGenerator replaces the project ID generation strategy. Generated IDs are
+// still validated against the GCP project ID format before they are used.
+func SetIDGenerator(generator IDGenerator) {
+	idGenerator = generator
+}
+
+// serviceAccountIDRegexp matches the service account IDs GCP accepts: 6-30
+// lowercase letters, digits or hyphens, starting with a letter and not ending
+// with a hyphen
+var serviceAccountIDRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// serviceAccountIDMaxLength is GCP's upper bound on service account IDs
+const serviceAccountIDMaxLength = 30
+
+// serviceAccountIDTemplate derives the project service account ID from the
+// claim. A nil template keeps the fixed osd-managed-admin ID.
+var serviceAccountIDTemplate *template.Template
+
+// SetServiceAccountIDTemplate installs a Go template rendered over the
+// ProjectClaim to derive the service account ID, e.g.
+// "admin-{{.Name}}". An empty template restores the fixed default ID.
+func SetServiceAccountIDTemplate(tmpl string) error {
+	if tmpl == "" {
+		serviceAccountIDTemplate = nil
+		return nil
+	}
+	parsed, err := template.New("serviceAccountID").Parse(tmpl)
+	if err != nil {
+		return operrors.Wrap(err, "could not parse the service account ID template")
+	}
+	serviceAccountIDTemplate = parsed
+	return nil
+}
+
+// serviceAccountID renders the configured template over the claim, lowercases
+// the result and truncates it to GCP's maximum length. Results that still
+// violate the service account ID format are rejected.
+func (r *ReferenceAdapter) serviceAccountID() (string, error) {
+	if serviceAccountIDTemplate == nil {
+		return osdServiceAccountName, nil
+	}
+	var rendered bytes.Buffer
+	if err := serviceAccountIDTemplate.Execute(&rendered, r.ProjectClaim); err != nil {
+		return "", operrors.Wrap(err, "could not render the service account ID template")
+	}
+	accountID := strings.ToLower(rendered.String())
+	if len(accountID) > serviceAccountIDMaxLength {
+		accountID = strings.TrimRight(accountID[:serviceAccountIDMaxLength], "-")
+	}
+	if !serviceAccountIDRegexp.MatchString(accountID) {
+		return "", operrors.Wrap(operrors.ErrInvalidServiceAccountID, fmt.Sprintf("service account ID %s does not satisfy GCP's format constraints", accountID))
+	}
+	return accountID, nil
+}
+
+// projectIDRegexp matches the project IDs GCP accepts: 6-30 lowercase letters,
+// digits or hyphens, starting with a letter and not ending with a hyphen
+var projectIDRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// UpdateProjectID updates the ProjectReference with a unique ID for the ProjectID
+func (r *ReferenceAdapter) UpdateProjectID() error {
+	projectID, err := idGenerator.GenerateProjectID(r.ProjectClaim)
+	if err != nil {
+		return err
+	}
+	if !projectIDRegexp.MatchString(projectID) {
+		return operrors.Wrap(operrors.ErrInvalidProjectID, fmt.Sprintf("generated project ID %s is not a valid GCP project ID", projectID))
+	}
+	r.ProjectReference.Spec.GCPProjectID = projectID
+	return r.kubeClient.Update(context.TODO(), r.ProjectReference)
+}
+
+// finalizationBaseDelay is the delay after the first failed finalization attempt.
+const finalizationBaseDelay = 5 * time.Second
+
+// finalizationBackoffCap bounds the exponential backoff between finalization retries.
+var finalizationBackoffCap = 10 * time.Minute
+
+// SetFinalizationBackoffCap overrides the maximum delay between finalization retries.
+func SetFinalizationBackoffCap(cap time.Duration) {
+	finalizationBackoffCap = cap
+}
+
+// EnsureDanglingReferenceProcessed cleans up a ProjectReference whose linked
+// ProjectClaim no longer exists, e.g. because claim finalization failed before
+// the reference was deleted. The GCP resources are cleaned up and the finalizer
+// removed so the reference does not linger without an owner.
+func EnsureDanglingReferenceProcessed(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !adapter.claimMissing {
+		return gcputil.ContinueProcessing()
 	}
 
-	r.logger.V(2).Info("Project Ready update matchingClaim to ready")
-	r.ProjectClaim.Status.State = gcpv1alpha1.ClaimStatusReady
-	err = r.kubeClient.Status().Update(context.TODO(), r.ProjectClaim)
+	adapter.logger.Info("Linked ProjectClaim no longer exists, cleaning up the dangling ProjectReference")
+	err := adapter.EnsureProjectCleanedUp()
 	if err != nil {
-		return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectClaim status"))
+		return gcputil.RequeueAfter(adapter.finalizationBackoff(), err)
+	}
+
+	if !adapter.IsDeletionRequested() {
+		err = adapter.kubeClient.Delete(context.TODO(), adapter.ProjectReference)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "error deleting the dangling ProjectReference"))
+		}
 	}
 	return gcputil.StopProcessing()
 }
 
-func VerifyProjectClaimPending(r *ReferenceAdapter) (gcputil.OperationResult, error) {
-	if r.ProjectClaim.Status.State != gcpv1alpha1.ClaimStatusPendingProject {
-		return gcputil.RequeueAfter(5*time.Second, nil)
+func EnsureDeletionProcessed(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
+	// Cleanup
+	if adapter.IsDeletionRequested() {
+		if result, err := adapter.verifyProjectEmpty(); err != nil || result.RequeueRequest {
+			return result, err
+		}
+		err := adapter.EnsureProjectCleanedUp()
+		if err != nil {
+			return gcputil.RequeueAfter(adapter.finalizationBackoff(), err)
+		}
+		return gcputil.StopProcessing()
 	}
 	return gcputil.ContinueProcessing()
 }
 
-func EnsureProjectReferenceStatusCreating(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
-	if adapter.ProjectReference.Status.State != "" {
-		return gcputil.ContinueProcessing()
-	}
-	adapter.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusCreating
-	err := adapter.kubeClient.Status().Update(context.TODO(), adapter.ProjectReference)
-	if err != nil {
-		err = operrors.Wrap(err, "error updating ProjectReference status")
-		return gcputil.RequeueWithError(err)
-	}
-	return gcputil.StopProcessing()
+// TeardownAnnotation holds a JSON description of the resources that finalizing
+// the ProjectReference would destroy, so operators can review what a deletion
+// entails before removing the claim.
+const TeardownAnnotation = "gcp.managed.openshift.io/teardown"
+
+// teardownAnnotationEnabled holds the operator-wide switch for publishing the
+// teardown annotation.
+var teardownAnnotationEnabled bool
+
+// SetTeardownAnnotationEnabled toggles publishing of the teardown annotation
+// on ProjectReferences.
+func SetTeardownAnnotationEnabled(enabled bool) {
+	teardownAnnotationEnabled = enabled
 }
 
-func EnsureProjectID(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
-	if adapter.ProjectReference.Spec.GCPProjectID != "" {
-		return gcputil.ContinueProcessing()
+// TeardownStep describes a single resource that finalizing the ProjectReference would destroy
+type TeardownStep struct {
+	// Kind is the kind of resource, e.g. project or serviceAccount
+	Kind string `json:"kind"`
+	// Name identifies the resource within its kind
+	Name string `json:"name"`
+}
+
+// DescribeTeardown returns the resources that finalizing the ProjectReference
+// would destroy, derived from its spec and status without querying GCP. The
+// list mirrors EnsureProjectCleanedUp: the project itself with everything in it
+// (unless customer supplied), the workload identity pool and the copied
+// credential Secret.
+func (r *ReferenceAdapter) DescribeTeardown() []TeardownStep {
+	steps := []TeardownStep{}
+	projectID := r.ProjectReference.Spec.GCPProjectID
+	if !r.isCCS() && projectID != "" {
+		steps = append(steps, TeardownStep{Kind: "project", Name: projectID})
+		// The configured template decides the account ID; fall back to the
+		// default ID when it does not render for this claim
+		accountID, err := r.serviceAccountID()
+		if err != nil {
+			accountID = osdServiceAccountName
+		}
+		steps = append(steps, TeardownStep{Kind: "serviceAccount", Name: fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountID, projectID)})
+		if !r.ProjectReference.Spec.DeleteDefaultNetwork {
+			steps = append(steps, TeardownStep{Kind: "network", Name: "default"})
+		}
 	}
-	adapter.logger.V(1).Info("Creating ProjectID in ProjectReference CR")
-	err := adapter.UpdateProjectID()
-	if err != nil {
-		err = operrors.Wrap(err, "could not update ProjectID in Project Reference CR")
-		return gcputil.RequeueWithError(err)
+	if r.ProjectReference.Spec.WorkloadIdentity != nil {
+		steps = append(steps, TeardownStep{Kind: "workloadIdentityPool", Name: r.ProjectReference.Spec.WorkloadIdentity.PoolID})
 	}
-	return gcputil.StopProcessing()
+	if !r.claimMissing && r.ProjectClaim.Spec.GCPCredentialSecret.Name != "" {
+		steps = append(steps, TeardownStep{Kind: "secret", Name: r.ProjectClaim.Spec.GCPCredentialSecret.Namespace + "/" + r.ProjectClaim.Spec.GCPCredentialSecret.Name})
+	}
+	return steps
 }
 
-func EnsureProjectCreated(r *ReferenceAdapter) (gcputil.OperationResult, error) {
-	if r.isCCS() {
+// EnsureTeardownDescribed publishes the teardown description in the teardown
+// annotation of the ProjectReference, keeping it current as the reference grows
+// resources over its lifetime.
+func EnsureTeardownDescribed(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if !teardownAnnotationEnabled {
 		return gcputil.ContinueProcessing()
 	}
-	configMap, err := r.getConfigMap()
-	if err != nil {
-		return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not get ConfigMap: %s Operator Namespace: %s", orgGcpConfigMap, operatorNamespace)))
-	}
 
-	err = r.createProject(configMap.ParentFolderID)
+	described, err := json.Marshal(r.DescribeTeardown())
 	if err != nil {
-		if err == operrors.ErrInactiveProject {
-			r.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusError
-			err := r.kubeClient.Status().Update(context.TODO(), r.ProjectReference)
-			if err != nil {
-				return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectReference status"))
-			}
-			return gcputil.StopProcessing()
-		}
-		return gcputil.RequeueWithError(operrors.Wrap(err, "could not create project"))
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error describing the teardown steps"))
 	}
-
-	r.logger.V(1).Info("Configuring Billing APIS")
-	err = r.configureBillingAPI(configMap)
-	if err != nil {
-		return gcputil.RequeueWithError(operrors.Wrap(err, "error configuring Billing APIS"))
+	if r.ProjectReference.GetAnnotations()[TeardownAnnotation] == string(described) {
+		return gcputil.ContinueProcessing()
 	}
 
-	return gcputil.ContinueProcessing()
+	annotations := r.ProjectReference.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[TeardownAnnotation] = string(described)
+	r.ProjectReference.SetAnnotations(annotations)
+	return gcputil.RequeueOnErrorOrContinue(r.kubeClient.Update(context.TODO(), r.ProjectReference))
 }
 
-func (r *ReferenceAdapter) isCCS() bool {
-	return r.ProjectReference.Spec.CCS
-}
+// verifyProjectEmpty blocks finalization in safe mode while the project still
+// holds resources. Leftover non-default service accounts or running compute
+// instances usually mean the project was not deprovisioned properly and deleting
+// it would be destructive. The deletion-override annotation skips the check for
+// a single ProjectReference.
+func (r *ReferenceAdapter) verifyProjectEmpty() (gcputil.OperationResult, error) {
+	if !deletionSafeMode || r.isCCS() || r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if r.ProjectReference.GetAnnotations()[DeletionOverrideAnnotation] == "true" {
+		return gcputil.ContinueProcessing()
+	}
 
-func EnsureProjectConfigured(r *ReferenceAdapter) (gcputil.OperationResult, error) {
-	r.logger.V(1).Info("Configuring APIS")
-	err := r.configureAPIS()
+	leftovers := []string{}
+	serviceAccounts, err := r.gcpClient.ListServiceAccounts(r.ProjectReference.Spec.GCPProjectID)
 	if err != nil {
-		return gcputil.RequeueWithError(operrors.Wrap(err, "error configuring APIS"))
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error listing service accounts for the safe-mode deletion check"))
 	}
-
-	r.logger.V(1).Info("Configuring Service Account")
-	result, err := r.configureServiceAccount()
-	if err != nil || result.RequeueRequest {
-		return result, err
+	for _, serviceAccount := range serviceAccounts {
+		if isDefaultServiceAccount(serviceAccount.Email) {
+			continue
+		}
+		leftovers = append(leftovers, fmt.Sprintf("serviceAccount %s", serviceAccount.Email))
 	}
 
-	r.logger.V(1).Info("Creating Credentials")
-	result, err = r.createCredentials()
+	instances, err := r.gcpClient.ListRunningInstances(r.ProjectReference.Spec.GCPProjectID)
 	if err != nil {
-		return gcputil.RequeueWithError(operrors.Wrap(err, "error creating credentials"))
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error listing running instances for the safe-mode deletion check"))
+	}
+	for _, instance := range instances {
+		leftovers = append(leftovers, fmt.Sprintf("instance %s", instance))
 	}
 
-	return result, nil
-}
+	if len(leftovers) == 0 {
+		return gcputil.ContinueProcessing()
+	}
 
-func EnsureStateReady(r *ReferenceAdapter) (gcputil.OperationResult, error) {
-	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady {
-		r.logger.V(1).Info("Setting Status on projectReference")
-		r.ProjectReference.Status.State = gcpv1alpha1.ProjectReferenceStatusReady
-		return gcputil.RequeueOnErrorOrStop(r.kubeClient.Status().Update(context.TODO(), r.ProjectReference))
+	message := fmt.Sprintf("project still holds resources: %s; set the %s annotation to delete it anyway", strings.Join(leftovers, ", "), DeletionOverrideAnnotation)
+	r.logger.Info("Blocking project deletion in safe mode", "Leftovers", leftovers)
+	r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionProjectNotEmpty, corev1.ConditionTrue, "ProjectNotEmpty", message)
+	if err := r.StatusUpdate(); err != nil {
+		return gcputil.RequeueWithError(operrors.Wrap(err, "error updating ProjectReference status"))
 	}
-	return gcputil.ContinueProcessing()
+	return gcputil.RequeueAfter(5*time.Minute, operrors.Wrap(operrors.ErrProjectNotEmpty, message))
 }
 
-func getMatchingClaimLink(projectReference *gcpv1alpha1.ProjectReference, client client.Client) (*gcpv1alpha1.ProjectClaim, error) {
-	projectClaim := &gcpv1alpha1.ProjectClaim{}
-	err := client.Get(context.TODO(), types.NamespacedName{Name: projectReference.Spec.ProjectClaimCRLink.Name, Namespace: projectReference.Spec.ProjectClaimCRLink.Namespace}, projectClaim)
-	if err != nil {
-		return &gcpv1alpha1.ProjectClaim{}, err
-
-	}
-	return projectClaim, nil
+// isDefaultServiceAccount reports whether a service account is GCP-managed or
+// operator-managed and therefore expected to exist in every project.
+func isDefaultServiceAccount(email string) bool {
+	return strings.HasPrefix(email, osdServiceAccountName+"@") ||
+		strings.HasSuffix(email, "-compute@developer.gserviceaccount.com") ||
+		strings.HasSuffix(email, "@appspot.gserviceaccount.com")
 }
 
-// UpdateProjectID updates the ProjectReference with a unique ID for the ProjectID
-func (r *ReferenceAdapter) UpdateProjectID() error {
-	projectID, err := GenerateProjectID()
-	if err != nil {
-		return err
+// finalizationBackoff records the failed finalization attempt on the status and
+// returns the capped exponential delay until the next retry, so a GCP outage is
+// not hammered at a fixed interval.
+func (r *ReferenceAdapter) finalizationBackoff() time.Duration {
+	r.ProjectReference.Status.FinalizationRetries++
+	if err := r.StatusUpdate(); err != nil {
+		r.logger.Error(err, "failed to record the finalization retry count")
 	}
-	r.ProjectReference.Spec.GCPProjectID = projectID
-	return r.kubeClient.Update(context.TODO(), r.ProjectReference)
-}
 
-func EnsureDeletionProcessed(adapter *ReferenceAdapter) (gcputil.OperationResult, error) {
-	// Cleanup
-	if adapter.IsDeletionRequested() {
-		err := adapter.EnsureProjectCleanedUp()
-		if err != nil {
-			return gcputil.RequeueAfter(5*time.Second, err)
+	delay := finalizationBaseDelay
+	for i := 1; i < r.ProjectReference.Status.FinalizationRetries; i++ {
+		delay *= 2
+		if delay >= finalizationBackoffCap {
+			return finalizationBackoffCap
 		}
-		return gcputil.StopProcessing()
 	}
-	return gcputil.ContinueProcessing()
+	return delay
 }
 
 // IsDeletionRequested checks the metadata.deletionTimestamp of ProjectReference instance, and returns if delete requested.
@@ -298,6 +1695,36 @@ func (r *ReferenceAdapter) EnsureFinalizerDeleted() error {
 
 // EnsureProjectCleanedUp deletes the project, the secret and the finalizer if they still exist
 func (r *ReferenceAdapter) EnsureProjectCleanedUp() error {
+	if r.ProjectReference.Spec.WorkloadIdentity != nil {
+		err := r.gcpClient.DeleteWorkloadIdentityPool(r.ProjectReference.Spec.GCPProjectID, r.ProjectReference.Spec.WorkloadIdentity.PoolID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// KMS keyrings and their keys cannot be deleted, so the project's keyrings
+	// are skipped and noted in a condition. The status write is best effort:
+	// finalization must not get stuck on it.
+	if len(r.ProjectReference.Spec.KMSKeyRings) > 0 {
+		r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionKMSKeyRingsRetained, corev1.ConditionTrue, "KeyRingsNotDeletable", fmt.Sprintf("KMS keyrings cannot be deleted; %d keyring(s) are left behind with the project", len(r.ProjectReference.Spec.KMSKeyRings)))
+		_ = r.StatusUpdate()
+	}
+
+	// Unbind resource manager tags before the project goes away so the
+	// bindings do not linger in the resource manager. A tag value that no
+	// longer resolves was deleted along with its bindings, so lookup
+	// failures are tolerated here.
+	for key, value := range r.ProjectReference.Spec.Tags {
+		tagValueName, err := r.gcpClient.LookupTagValue(key, value)
+		if err != nil {
+			continue
+		}
+		err = r.gcpClient.DeleteTagBinding(r.ProjectReference.Spec.GCPProjectID, tagValueName)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !r.isCCS() {
 		err := r.deleteProject()
 		if err != nil {
@@ -356,6 +1783,9 @@ func (r *ReferenceAdapter) deleteProject() error {
 	case "ACTIVE":
 		r.logger.Info("Deleting Project")
 		_, err := r.gcpClient.DeleteProject(project.ProjectId)
+		if err == nil {
+			r.publishAudit("DeleteProject")
+		}
 		return err
 	default:
 		return fmt.Errorf("ProjectReference Controller is unable to understand the project.LifecycleState %s", project.LifecycleState)
@@ -384,13 +1814,26 @@ func (r *ReferenceAdapter) createProject(parentFolderID string) error {
 	// If we cannot create the project clear the projectID from spec so we can try again with another unique key
 	_, creationFailed := r.gcpClient.CreateProject(parentFolderID)
 	if creationFailed != nil {
+		// A quota error is not an ID collision: keep the project ID, retrying
+		// with a fresh one cannot succeed until the quota replenishes.
+		if isQuotaExhausted(creationFailed) {
+			return operrors.Wrap(operrors.ErrProjectQuotaExhausted, fmt.Sprintf("could not create project, creation quota is exhausted: %v", creationFailed))
+		}
 		r.logger.V(1).Info("Clearing gcpProjectID from ProjectReferenceSpec")
 		//Todo() We need to requeue here ot it will continue to the next step.
 		if err = r.clearProjectID(); err != nil {
 			return operrors.Wrap(creationFailed, fmt.Sprintf("could not clear project ID: %v", err))
 		}
 
-		return operrors.Wrap(creationFailed, fmt.Sprintf("could not create project. Parent Folder ID: %s, Requested Project ID: %s", parentFolderID, r.ProjectReference.Spec.GCPProjectID))
+		return operrors.Wrap(operrors.ErrProjectIDTaken, fmt.Sprintf("could not create project. Parent Folder ID: %s, Requested Project ID: %s: %v", parentFolderID, r.ProjectReference.Spec.GCPProjectID, creationFailed))
+	}
+	r.publishAudit("CreateProject")
+
+	if r.ProjectClaim.Status.Timeline.ProjectCreated.IsZero() {
+		r.ProjectClaim.Status.Timeline.ProjectCreated = metav1.Now()
+		if err := r.kubeClient.Status().Update(context.TODO(), r.ProjectClaim); err != nil {
+			return operrors.Wrap(err, "error recording the project creation milestone on the ProjectClaim")
+		}
 	}
 
 	return nil
@@ -410,6 +1853,11 @@ func (r *ReferenceAdapter) getProject(projectId string) (*cloudresourcemanager.P
 }
 
 func (r *ReferenceAdapter) configureBillingAPI(config configmap.OperatorConfigMap) error {
+	if r.ProjectReference.Spec.SkipBillingManagement {
+		r.logger.V(1).Info("Skipping billing management as requested by the claim")
+		return nil
+	}
+
 	enabledAPIs, err := r.gcpClient.ListAPIs(r.ProjectReference.Spec.GCPProjectID)
 	if err != nil {
 		return err
@@ -425,8 +1873,9 @@ func (r *ReferenceAdapter) configureBillingAPI(config configmap.OperatorConfigMa
 
 	err = r.gcpClient.CreateCloudBillingAccount(r.ProjectReference.Spec.GCPProjectID, config.BillingAccount)
 	if err != nil {
-		return operrors.Wrap(err, "error creating CloudBilling")
+		return operrors.Wrap(operrors.ErrBillingLinkFailed, fmt.Sprintf("error creating CloudBilling: %v", err))
 	}
+	r.publishAudit("LinkBillingAccount")
 
 	return nil
 }
@@ -437,7 +1886,9 @@ func (r *ReferenceAdapter) configureAPIS() error {
 		return err
 	}
 
-	for _, api := range OSDRequiredAPIS {
+	// Requested APIs are enabled after the mandatory defaults; the claim
+	// controller has already validated them against the approved catalog
+	for _, api := range append(append([]string{}, OSDRequiredAPIS...), r.ProjectReference.Spec.RequestedAPIs...) {
 		if !util.Contains(enabledAPIs, api) {
 			err = r.gcpClient.EnableAPI(r.ProjectReference.Spec.GCPProjectID, api)
 			if err != nil {
@@ -449,6 +1900,24 @@ func (r *ReferenceAdapter) configureAPIS() error {
 	return nil
 }
 
+// VerifyAPIsPropagated checks that every required API reports ENABLED on the
+// project. Enablement returns before the change has propagated, and creating
+// resources in that window fails with SERVICE_DISABLED, so requeue until every
+// service has settled.
+func (r *ReferenceAdapter) VerifyAPIsPropagated() (gcputil.OperationResult, error) {
+	for _, api := range append(append([]string{}, OSDRequiredAPIS...), r.ProjectReference.Spec.RequestedAPIs...) {
+		state, err := r.gcpClient.GetServiceState(r.ProjectReference.Spec.GCPProjectID, api)
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("error checking the enablement state of %s", api)))
+		}
+		if state != "ENABLED" {
+			r.logger.V(2).Info("API enablement has not propagated yet. Retrying in 30 seconds.", "api", api, "state", state)
+			return gcputil.RequeueAfter(30*time.Second, nil)
+		}
+	}
+	return gcputil.ContinueProcessing()
+}
+
 func (r *ReferenceAdapter) getConfigMap() (configmap.OperatorConfigMap, error) {
 	operatorConfigMap, err := configmap.GetOperatorConfigMap(r.kubeClient)
 	if err != nil {
@@ -463,19 +1932,24 @@ func (r *ReferenceAdapter) getConfigMap() (configmap.OperatorConfigMap, error) {
 }
 
 func (r *ReferenceAdapter) configureServiceAccount() (gcputil.OperationResult, error) {
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+
 	// See if GCP service account exists if not create it
 	var serviceAccount *iam.ServiceAccount
-	serviceAccount, err := r.gcpClient.GetServiceAccount(osdServiceAccountName)
+	serviceAccount, err = r.gcpClient.GetServiceAccount(accountID)
 	if err != nil {
 		// Create OSDManged Service account
 		r.logger.Info("Creating Service Account")
-		account, err := r.gcpClient.CreateServiceAccount(osdServiceAccountName, osdServiceAccountName)
+		account, err := r.gcpClient.CreateServiceAccount(accountID, accountID)
 		if err != nil {
 			if matchesAlreadyExistsError(err) {
 				r.logger.V(2).Info("Service Account not yet fully initialized. Retrying in 30 seconds.")
 				return gcputil.RequeueAfter(30*time.Second, nil)
 			}
-			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create service account for %s", osdServiceAccountName)))
+			return gcputil.RequeueWithError(operrors.Wrap(err, fmt.Sprintf("could not create service account for %s", accountID)))
 		}
 		serviceAccount = account
 	}
@@ -489,13 +1963,61 @@ func (r *ReferenceAdapter) configureServiceAccount() (gcputil.OperationResult, e
 	return gcputil.ContinueProcessing()
 }
 
+// credentialsSecretValid reports whether the credentials secret contains key material
+func credentialsSecretValid(secret *corev1.Secret) bool {
+	return len(secret.Data["osServiceAccount.json"]) > 0
+}
+
+// credentialsReadyConditionStatus returns the current status of the CredentialsReady
+// condition on the reference, or an empty status if the condition is not set yet
+func (r *ReferenceAdapter) credentialsReadyConditionStatus() corev1.ConditionStatus {
+	for _, cond := range r.ProjectReference.Status.Conditions {
+		if cond.Type == gcpv1alpha1.ConditionCredentialsReady {
+			return cond.Status
+		}
+	}
+	return ""
+}
+
+// setCredentialsReadyCondition sets the CredentialsReady condition on the reference
+// and mirrors it to the claim, so consumers have a single signal that the secret is usable
+func (r *ReferenceAdapter) setCredentialsReadyCondition(status corev1.ConditionStatus, reason, message string) error {
+	r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionCredentialsReady, status, reason, message)
+	if err := r.StatusUpdate(); err != nil {
+		return err
+	}
+	r.conditionManager.SetCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionCredentialsReady, status, reason, message)
+	return gcputil.UpdateStatus(r.kubeClient, r.ProjectClaim)
+}
+
 func (r *ReferenceAdapter) createCredentials() (gcputil.OperationResult, error) {
-	if gcputil.SecretExists(r.kubeClient, r.ProjectClaim.Spec.GCPCredentialSecret.Name, r.ProjectClaim.Spec.GCPCredentialSecret.Namespace) {
+	if r.ProjectReference.Spec.SkipCredentialsSecret {
+		r.logger.V(1).Info("Skipping service account key and credentials secret creation")
+		return gcputil.ContinueProcessing()
+	}
+
+	existingSecret, err := gcputil.GetSecret(r.kubeClient, r.ProjectClaim.Spec.GCPCredentialSecret.Name, r.ProjectClaim.Spec.GCPCredentialSecret.Namespace)
+	if err == nil {
+		if !credentialsSecretValid(existingSecret) {
+			_ = r.setCredentialsReadyCondition(corev1.ConditionFalse, "CredentialsSecretInvalid", fmt.Sprintf("secret %s contains no key material", r.ProjectClaim.Spec.GCPCredentialSecret.Name))
+			return gcputil.RequeueWithError(operrors.Wrap(operrors.ErrInvalidCredentialsSecret, fmt.Sprintf("secret %s contains no key material", r.ProjectClaim.Spec.GCPCredentialSecret.Name)))
+		}
+		if r.credentialsReadyConditionStatus() != corev1.ConditionTrue {
+			return gcputil.RequeueOnErrorOrContinue(r.setCredentialsReadyCondition(corev1.ConditionTrue, "CredentialsSecretValid", "the credentials secret contains valid key material"))
+		}
 		return gcputil.ContinueProcessing()
 	}
 
+	if r.credentialsReadyConditionStatus() != corev1.ConditionFalse {
+		_ = r.setCredentialsReadyCondition(corev1.ConditionFalse, "CredentialsSecretPending", "the credentials secret has not been written yet")
+	}
+
 	r.logger.Info("Creating credentials")
-	serviceAccount, err := r.gcpClient.GetServiceAccount(osdServiceAccountName)
+	accountID, err := r.serviceAccountID()
+	if err != nil {
+		return gcputil.RequeueWithError(err)
+	}
+	serviceAccount, err := r.gcpClient.GetServiceAccount(accountID)
 	if err != nil {
 		if matchesNotFoundError(err) {
 			r.logger.V(1).Info("Service Account not yet fully initialized. Retrying in 30 seconds.")
@@ -515,7 +2037,7 @@ func (r *ReferenceAdapter) createCredentials() (gcputil.OperationResult, error)
 		return gcputil.RequeueWithError(operrors.Wrap(err, "could not decode secret"))
 	}
 
-	secret := gcputil.NewGCPSecretCR(string(privateKeyString), types.NamespacedName{
+	secret := gcputil.NewGCPSecretCR(string(privateKeyString), r.ProjectReference.Spec.GCPProjectID, types.NamespacedName{
 		Namespace: r.ProjectClaim.Spec.GCPCredentialSecret.Namespace,
 		Name:      r.ProjectClaim.Spec.GCPCredentialSecret.Name,
 	})
@@ -526,7 +2048,7 @@ func (r *ReferenceAdapter) createCredentials() (gcputil.OperationResult, error)
 		return gcputil.RequeueWithError(operrors.Wrap(createErr, fmt.Sprintf("could not create service account secret for %s", r.ProjectClaim.Spec.GCPCredentialSecret.Name)))
 	}
 
-	return gcputil.ContinueProcessing()
+	return gcputil.RequeueOnErrorOrContinue(r.setCredentialsReadyCondition(corev1.ConditionTrue, "CredentialsSecretCreated", "the credentials secret was written with valid key material"))
 }
 
 func (r *ReferenceAdapter) deleteCredentials() error {
@@ -544,6 +2066,10 @@ func (r *ReferenceAdapter) deleteCredentials() error {
 			return operrors.Wrap(err, fmt.Sprintf("could not get the service account secret for %s", secret.Name))
 		}
 
+		if retention := r.ProjectClaim.Spec.CredentialsRetention; retention != nil && retention.Duration > 0 {
+			return r.retainCredentials(key, retention.Duration)
+		}
+
 		r.logger.V(2).Info("Deleting secret")
 		err = r.kubeClient.Delete(context.TODO(), key)
 		if err != nil {
@@ -554,6 +2080,78 @@ func (r *ReferenceAdapter) deleteCredentials() error {
 	return nil
 }
 
+// retainCredentials marks the credentials secret for deferred deletion instead
+// of deleting it, so cleanup jobs can keep using the credentials during the
+// claim's retention window. The sweep removes the secret once the recorded
+// deletion time has passed.
+func (r *ReferenceAdapter) retainCredentials(secret *corev1.Secret, retention time.Duration) error {
+	annotations := secret.GetAnnotations()
+	if _, marked := annotations[CredentialsDeleteAfterAnnotation]; marked {
+		return nil
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	deleteAfter := time.Now().Add(retention).UTC().Format(time.RFC3339)
+	annotations[CredentialsDeleteAfterAnnotation] = deleteAfter
+	secret.SetAnnotations(annotations)
+	r.logger.Info("Retaining credentials secret past claim deletion", "Secret", secret.GetName(), "DeleteAfter", deleteAfter)
+	if err := r.kubeClient.Update(context.TODO(), secret); err != nil {
+		return operrors.Wrap(err, fmt.Sprintf("could not mark service account secret %s for deferred deletion", secret.GetName()))
+	}
+	return nil
+}
+
+// SweepRetainedCredentials deletes operator-managed credentials secrets whose
+// retention window has passed, and returns how many were removed. Secrets with
+// a malformed deletion timestamp are deleted as well rather than lingering
+// forever.
+func SweepRetainedCredentials(ctx context.Context, kubeClient client.Client) (int, error) {
+	secretList := &corev1.SecretList{}
+	if err := kubeClient.List(ctx, client.MatchingLabels(map[string]string{gcputil.ManagedByLabel: gcputil.ManagedByValue}), secretList); err != nil {
+		return 0, err
+	}
+
+	swept := 0
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		deleteAfter, marked := secret.GetAnnotations()[CredentialsDeleteAfterAnnotation]
+		if !marked {
+			continue
+		}
+		expiry, err := time.Parse(time.RFC3339, deleteAfter)
+		if err == nil && time.Now().Before(expiry) {
+			continue
+		}
+		if err := kubeClient.Delete(ctx, secret); err != nil {
+			return swept, operrors.Wrap(err, fmt.Sprintf("could not sweep retained credentials secret %s", secret.GetName()))
+		}
+		swept++
+	}
+	return swept, nil
+}
+
+// credentialsSweepInterval is how often retained credentials secrets are
+// checked for an expired retention window.
+const credentialsSweepInterval = 5 * time.Minute
+
+// StartCredentialsSweep periodically removes retained credentials secrets
+// whose retention window has passed, until the stop channel closes.
+func StartCredentialsSweep(kubeClient client.Client, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(credentialsSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if _, err := SweepRetainedCredentials(context.TODO(), kubeClient); err != nil {
+				log.Error(err, "could not sweep retained credentials secrets")
+			}
+		}
+	}
+}
+
 // ensureAvailabilityZonesSet sets the az in the projectclaim spec if necessary
 // returns true if the project claim has been modified
 func (r *ReferenceAdapter) ensureClaimAvailabilityZonesSet() (ensureAzResult, error) {
@@ -561,9 +2159,13 @@ func (r *ReferenceAdapter) ensureClaimAvailabilityZonesSet() (ensureAzResult, er
 		return ensureAzResultNoChange, nil
 	}
 
-	zones, err := r.gcpClient.ListAvailabilityZones(r.ProjectReference.Spec.GCPProjectID, r.ProjectClaim.Spec.Region)
-	if err != nil {
-		return r.handleAvailabilityZonesError(err)
+	var zones []string
+	for _, region := range r.ProjectClaim.Spec.EffectiveRegions() {
+		regionZones, err := r.gcpClient.ListAvailabilityZones(r.ProjectReference.Spec.GCPProjectID, region)
+		if err != nil {
+			return r.handleAvailabilityZonesError(err)
+		}
+		zones = append(zones, regionZones...)
 	}
 	conditions := &r.ProjectReference.Status.Conditions
 	r.conditionManager.SetCondition(conditions, gcpv1alpha1.ConditionComputeApiReady, corev1.ConditionTrue, "QueryAvailabilityZonesSucceeded", "ComputeAPI ready, successfully queried availability zones")
@@ -610,6 +2212,101 @@ func EnsureProjectReferenceInitialized(r *ReferenceAdapter) (gcputil.OperationRe
 	return gcputil.ContinueProcessing()
 }
 
+// applyRoleBindings merges the requested role bindings into policy. In
+// additive mode the listed members are only added to the listed roles; in
+// authoritative mode members not listed in the spec are also removed from
+// those roles. Roles not listed in the spec are never touched. It reports
+// whether the policy was modified and describes every removal.
+func applyRoleBindings(policy *cloudresourcemanager.Policy, roleBindings []gcpv1alpha1.RoleBinding, mode gcpv1alpha1.IAMPolicyMode) (bool, []string) {
+	modified := false
+	removals := []string{}
+	for _, roleBinding := range roleBindings {
+		var binding *cloudresourcemanager.Binding
+		for _, existing := range policy.Bindings {
+			if existing.Role == roleBinding.Role {
+				binding = existing
+				break
+			}
+		}
+		if binding == nil {
+			policy.Bindings = append(policy.Bindings, &cloudresourcemanager.Binding{
+				Role:    roleBinding.Role,
+				Members: append([]string{}, roleBinding.Members...),
+			})
+			modified = true
+			continue
+		}
+
+		members := []string{}
+		for _, member := range binding.Members {
+			if listed, _ := gcputil.InArray(member, roleBinding.Members); listed {
+				members = append(members, member)
+			} else if mode == gcpv1alpha1.IAMPolicyModeAuthoritative {
+				removals = append(removals, fmt.Sprintf("removed %s from %s", member, roleBinding.Role))
+				modified = true
+			} else {
+				members = append(members, member)
+			}
+		}
+		for _, member := range roleBinding.Members {
+			if held, _ := gcputil.InArray(member, members); !held {
+				members = append(members, member)
+				modified = true
+			}
+		}
+		binding.Members = members
+	}
+	return modified, removals
+}
+
+// EnsureRoleBindings applies the role bindings requested in the spec to the
+// project's IAM policy. In additive mode (the default) the listed members are
+// only granted the listed roles; in authoritative mode members granted a
+// listed role out-of-band are removed again, with each removal surfaced as an
+// event. The operation runs on the ready path, before the Ready short-circuit,
+// so authoritative removals and spec edits keep applying once the project is
+// Ready.
+func EnsureRoleBindings(r *ReferenceAdapter) (gcputil.OperationResult, error) {
+	if r.ProjectReference.Status.State != gcpv1alpha1.ProjectReferenceStatusReady || r.ProjectReference.Spec.GCPProjectID == "" {
+		return gcputil.ContinueProcessing()
+	}
+	if len(r.ProjectReference.Spec.RoleBindings) == 0 {
+		return gcputil.ContinueProcessing()
+	}
+
+	var retry int
+	for {
+		retry++
+		policy, err := r.gcpClient.GetIamPolicy(r.ProjectReference.Spec.GCPProjectID)
+		if err != nil {
+			return gcputil.RequeueWithError(operrors.Wrap(err, "could not get the IAM policy to apply the requested role bindings"))
+		}
+
+		modified, removals := applyRoleBindings(policy, r.ProjectReference.Spec.RoleBindings, r.ProjectReference.Spec.IAMPolicyMode)
+		if !modified {
+			return gcputil.ContinueProcessing()
+		}
+
+		r.logger.V(1).Info("Applying the requested role bindings", "Mode", string(r.ProjectReference.Spec.IAMPolicyMode))
+		_, err = r.gcpClient.SetIamPolicy(&cloudresourcemanager.SetIamPolicyRequest{Policy: policy})
+		if err != nil {
+			ae, ok := err.(*googleapi.Error)
+			if ok && ae.Code == http.StatusConflict && retry <= 3 {
+				continue
+			}
+			return gcputil.RequeueWithError(operrors.Wrap(err, "could not apply the requested role bindings"))
+		}
+
+		for _, removal := range removals {
+			r.logger.Info("Removed an IAM member not listed in the spec", "Removal", removal)
+			if r.recorder != nil {
+				r.recorder.Event(r.ProjectReference, corev1.EventTypeNormal, "IAMMemberRemoved", removal)
+			}
+		}
+		return gcputil.ContinueProcessing()
+	}
+}
+
 // AddorUpdateBindingResponse contines the data that is returned by the AddOrUpdarteBindings function
 type AddorUpdateBindingResponse struct {
 	modified bool
@@ -668,6 +2365,40 @@ func (r *ReferenceAdapter) SetIAMPolicy(serviceAccountEmail string) error {
 
 }
 
+// subsystemErrorConditions maps sentinel provisioning errors to the
+// subsystem-specific error condition raised when they occur. Unlike the
+// generic Error condition, these stay raised while other subsystems fail, so
+// concurrent problems are all visible at once. They are only lowered again
+// after a clean reconcile pass.
+var subsystemErrorConditions = map[error]gcpv1alpha1.ConditionType{
+	operrors.ErrBillingLinkFailed:     gcpv1alpha1.ConditionBillingError,
+	operrors.ErrProjectQuotaExhausted: gcpv1alpha1.ConditionQuotaError,
+}
+
+// SetSubsystemErrorConditions raises the subsystem-specific error condition
+// matching err, and lowers every raised subsystem condition again when err is
+// nil, since a clean pass means all subsystems succeeded. The conditions are
+// persisted by the Error condition write that follows every reconcile.
+func (r *ReferenceAdapter) SetSubsystemErrorConditions(err error) {
+	conditions := &r.ProjectReference.Status.Conditions
+	if err != nil {
+		for sentinel, conditionType := range subsystemErrorConditions {
+			if goerrors.Is(err, sentinel) {
+				r.conditionManager.SetCondition(conditions, conditionType, corev1.ConditionTrue, string(conditionType), err.Error())
+			}
+		}
+		return
+	}
+	for _, conditionType := range subsystemErrorConditions {
+		for _, cond := range *conditions {
+			if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+				r.conditionManager.SetCondition(conditions, conditionType, corev1.ConditionFalse, string(conditionType)+"Resolved", "")
+				break
+			}
+		}
+	}
+}
+
 // SetProjectReferenceCondition calls SetCondition() with project reference conditions
 // It returns nil if no conditions defined before and the err is nil
 // It updates the condition with err message, probe, etc... if err does exist
@@ -677,6 +2408,7 @@ func (r *ReferenceAdapter) SetProjectReferenceCondition(reason string, err error
 	conditionType := gcpv1alpha1.ConditionError
 	if err != nil {
 		r.conditionManager.SetCondition(conditions, conditionType, corev1.ConditionTrue, reason, err.Error())
+		r.ProjectReference.Status.RecentErrors = condition.AppendRecentError(r.ProjectReference.Status.RecentErrors, reason, err, condition.RecentErrorsMaxEntries)
 	} else {
 		if len(*conditions) != 0 {
 			reason = reason + "Resolved"
@@ -691,7 +2423,9 @@ func (r *ReferenceAdapter) SetProjectReferenceCondition(reason string, err error
 
 // StatusUpdate updates the project reference status
 func (r *ReferenceAdapter) StatusUpdate() error {
-	err := r.kubeClient.Status().Update(context.TODO(), r.ProjectReference)
+	r.ProjectReference.Status.Conditions = condition.PruneConditions(r.ProjectReference.Status.Conditions, condition.DefaultConditionRetention)
+	r.ProjectReference.Status.LastUpdate = metav1.Now()
+	err := gcputil.UpdateStatus(r.kubeClient, r.ProjectReference)
 	if err != nil {
 		return operrors.Wrap(err, fmt.Sprintf("failed to update ProjectClaim state for %s", r.ProjectReference.Name))
 	}
@@ -1,6 +1,7 @@
 package projectreference_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -8,20 +9,31 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	clusterapi "github.com/openshift/cluster-api/pkg/util"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iam/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 
 	api "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/audit"
 	. "github.com/openshift/gcp-project-operator/pkg/controller/projectreference"
 	"github.com/openshift/gcp-project-operator/pkg/util"
+	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
 	"github.com/openshift/gcp-project-operator/pkg/util/mocks"
 	mockconditions "github.com/openshift/gcp-project-operator/pkg/util/mocks/condition"
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
 	mockGCP "github.com/openshift/gcp-project-operator/pkg/util/mocks/gcpclient"
 	testStructs "github.com/openshift/gcp-project-operator/pkg/util/mocks/structs"
 )
@@ -41,6 +53,25 @@ var (
 	}
 )
 
+// recordingAuditSink captures submitted audit records for inspection
+type recordingAuditSink struct {
+	records []audit.Record
+}
+
+func (r *recordingAuditSink) Submit(record audit.Record) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+// staticIDGenerator returns a fixed project ID, standing in for an externally sourced generator
+type staticIDGenerator struct {
+	id string
+}
+
+func (s staticIDGenerator) GenerateProjectID(_ *gcpv1alpha1.ProjectClaim) (string, error) {
+	return s.id, nil
+}
+
 var _ = Describe("ProjectreferenceAdapter", func() {
 	var (
 		adapter          *ReferenceAdapter
@@ -99,6 +130,26 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 		})
 	})
 
+	Context("JitteredReadyRequeue", func() {
+		AfterEach(func() {
+			SetReadyRequeue(30*time.Minute, 0.1)
+		})
+
+		It("stays within the configured interval plus or minus the jitter bounds", func() {
+			SetReadyRequeue(10*time.Minute, 0.2)
+			for i := 0; i < 100; i++ {
+				delay := JitteredReadyRequeue()
+				Expect(delay).To(BeNumerically(">=", 8*time.Minute))
+				Expect(delay).To(BeNumerically("<=", 12*time.Minute))
+			}
+		})
+
+		It("returns the plain interval when jitter is disabled", func() {
+			SetReadyRequeue(10*time.Minute, 0)
+			Expect(JitteredReadyRequeue()).To(Equal(10 * time.Minute))
+		})
+	})
+
 	Context("EnsureProjectClaimUpdated", func() {
 		Context("When ProjectReference is in creating state", func() {
 			BeforeEach(func() {
@@ -153,19 +204,95 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 						})
 
 					})
+
+					Context("When the claim requests multiple regions and zones are not set", func() {
+						BeforeEach(func() {
+							projectClaim.Spec.Region = "us-east1"
+							projectClaim.Spec.Regions = []string{"us-east1", "europe-west1"}
+							mockGCPClient.EXPECT().ListAvailabilityZones(gomock.Any(), "us-east1").Return([]string{"us-east1-b"}, nil)
+							mockGCPClient.EXPECT().ListAvailabilityZones(gomock.Any(), "europe-west1").Return([]string{"europe-west1-c"}, nil)
+							mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any())
+							mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionComputeApiReady, corev1.ConditionTrue, "QueryAvailabilityZonesSucceeded", "ComputeAPI ready, successfully queried availability zones").Times(1)
+						})
+						It("collects the availability zones of every region", func() {
+							_, err := EnsureProjectClaimReady(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(adapter.ProjectClaim.Spec.AvailabilityZones).To(Equal([]string{"us-east1-b", "europe-west1-c"}))
+						})
+					})
 					Context("When availability zones are set already", func() {
 						BeforeEach(func() {
 							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
 							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+							mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionReconcileHold).Return(nil, false).Times(1)
 							projectClaim.Spec.AvailabilityZones = []string{"zone1", "zone2", "zone3"}
 							projectClaim.Spec.GCPProjectID = "fake-id"
 						})
 
-						It("sets state to Ready", func() {
+						It("sets state to Ready and stamps the Ready timeline milestone", func() {
 							_, err := EnsureProjectClaimReady(adapter)
 							Expect(err).NotTo(HaveOccurred())
 							Expect(adapter.ProjectClaim.Status.State).To(Equal(api.ClaimStatusReady))
+							Expect(adapter.ProjectClaim.Status.Timeline.Ready.IsZero()).To(BeFalse())
+
+						})
+					})
+
+					Context("When the reference status carries a LastUpdate timestamp", func() {
+						BeforeEach(func() {
+							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+							mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionReconcileHold).Return(nil, false).Times(1)
+							projectClaim.Spec.AvailabilityZones = []string{"zone1", "zone2", "zone3"}
+							projectClaim.Spec.GCPProjectID = "fake-id"
+							projectReference.Status.LastUpdate = metav1.NewTime(time.Now().Add(-90 * time.Second))
+						})
+
+						It("records the propagation lag since the reference update", func() {
+							_, err := EnsureProjectClaimReady(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							metric := &dto.Metric{}
+							Expect(localmetrics.ClaimPropagationLagSeconds.Write(metric)).To(Succeed())
+							Expect(metric.GetGauge().GetValue()).To(BeNumerically("~", 90, 5))
+						})
+					})
+
+					Context("When the hold annotation is set", func() {
+						BeforeEach(func() {
+							projectClaim.Status.State = api.ClaimStatusPendingProject
+							projectClaim.SetAnnotations(map[string]string{ClaimHoldAnnotation: "post-processing"})
+							mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionReconcileHold, corev1.ConditionTrue, "ReconcileHold", "post-processing").Times(1)
+							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+						})
+
+						It("stalls the claim at PendingProject and requeues", func() {
+							res, err := EnsureProjectClaimReady(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(res.RequeueRequest).To(BeTrue())
+							Expect(adapter.ProjectClaim.Status.State).To(Equal(api.ClaimStatusPendingProject))
+						})
+					})
+
+					Context("When the hold annotation was removed again", func() {
+						BeforeEach(func() {
+							projectClaim.Status.State = api.ClaimStatusPendingProject
+							projectClaim.Spec.AvailabilityZones = []string{"zone1", "zone2", "zone3"}
+							projectClaim.Spec.GCPProjectID = "fake-id"
+							holdCondition := gcpv1alpha1.Condition{
+								Type:   gcpv1alpha1.ConditionReconcileHold,
+								Status: corev1.ConditionTrue,
+							}
+							mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionReconcileHold).Return(&holdCondition, true).Times(1)
+							mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionReconcileHold, corev1.ConditionFalse, "HoldReleased", "").Times(1)
+							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+						})
 
+						It("advances the claim to Ready", func() {
+							_, err := EnsureProjectClaimReady(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(adapter.ProjectClaim.Status.State).To(Equal(api.ClaimStatusReady))
 						})
 					})
 				})
@@ -273,6 +400,1663 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 					})
 				})
 			})
+
+			Context("SetSubsystemErrorConditions()", func() {
+				Context("when billing fails", func() {
+					It("raises the BillingError condition", func() {
+						err := operrors.Wrap(operrors.ErrBillingLinkFailed, "error relinking billing account: fake error")
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingError, corev1.ConditionTrue, "BillingError", err.Error()).Times(1)
+						adapter.SetSubsystemErrorConditions(err)
+					})
+				})
+
+				Context("when the project creation quota is exhausted", func() {
+					It("raises the QuotaError condition", func() {
+						err := operrors.Wrap(operrors.ErrProjectQuotaExhausted, "could not create project, creation quota is exhausted")
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionQuotaError, corev1.ConditionTrue, "QuotaError", err.Error()).Times(1)
+						adapter.SetSubsystemErrorConditions(err)
+					})
+				})
+
+				Context("when a second subsystem fails while the first is still broken", func() {
+					It("raises QuotaError while BillingError stays raised", func() {
+						// BillingError raised by an earlier reconcile
+						adapter.ProjectReference.Status.Conditions = []gcpv1alpha1.Condition{
+							{Type: gcpv1alpha1.ConditionBillingError, Status: corev1.ConditionTrue, Reason: "BillingError"},
+						}
+						err := operrors.Wrap(operrors.ErrProjectQuotaExhausted, "creation quota is exhausted")
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionQuotaError, corev1.ConditionTrue, "QuotaError", err.Error()).Times(1)
+						adapter.SetSubsystemErrorConditions(err)
+						Expect(adapter.ProjectReference.Status.Conditions[0].Status).To(Equal(corev1.ConditionTrue))
+					})
+				})
+
+				Context("when a clean pass follows failures in two subsystems", func() {
+					It("lowers both subsystem conditions", func() {
+						adapter.ProjectReference.Status.Conditions = []gcpv1alpha1.Condition{
+							{Type: gcpv1alpha1.ConditionBillingError, Status: corev1.ConditionTrue, Reason: "BillingError"},
+							{Type: gcpv1alpha1.ConditionQuotaError, Status: corev1.ConditionTrue, Reason: "QuotaError"},
+						}
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingError, corev1.ConditionFalse, "BillingErrorResolved", "").Times(1)
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionQuotaError, corev1.ConditionFalse, "QuotaErrorResolved", "").Times(1)
+						adapter.SetSubsystemErrorConditions(nil)
+					})
+				})
+
+				Context("when the error matches no subsystem", func() {
+					It("does not raise a subsystem condition", func() {
+						adapter.SetSubsystemErrorConditions(errors.New("fake reconcile error"))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EnsureBillingLinked", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+			projectClaim.Spec.BillingAccountID = "desired-account"
+		})
+
+		Context("When no billing account is requested on the claim", func() {
+			BeforeEach(func() {
+				projectClaim.Spec.BillingAccountID = ""
+			})
+
+			It("continues processing", func() {
+				result, err := EnsureBillingLinked(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the claim skips billing management", func() {
+			BeforeEach(func() {
+				projectReference.Spec.SkipBillingManagement = true
+			})
+
+			It("issues no billing calls and continues processing", func() {
+				result, err := EnsureBillingLinked(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the requested billing account is already linked", func() {
+			It("does not relink", func() {
+				mockGCPClient.EXPECT().GetCloudBillingAccount("fake-gcp-project").Return("billingAccounts/desired-account", nil)
+				result, err := EnsureBillingLinked(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a different billing account is linked", func() {
+			It("relinks the desired billing account and sets the condition", func() {
+				mockGCPClient.EXPECT().GetCloudBillingAccount("fake-gcp-project").Return("billingAccounts/other-account", nil)
+				mockGCPClient.EXPECT().CreateCloudBillingAccount("fake-gcp-project", "desired-account").Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingReady, corev1.ConditionTrue, "BillingAccountLinked", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				result, err := EnsureBillingLinked(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("publishes an audit record for the relink", func() {
+				sink := &recordingAuditSink{}
+				audit.SetSink(sink)
+				defer audit.SetSink(nil)
+				mockGCPClient.EXPECT().GetCloudBillingAccount("fake-gcp-project").Return("billingAccounts/other-account", nil)
+				mockGCPClient.EXPECT().CreateCloudBillingAccount("fake-gcp-project", "desired-account").Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingReady, corev1.ConditionTrue, "BillingAccountLinked", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := EnsureBillingLinked(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sink.records).To(HaveLen(1))
+				Expect(sink.records[0].Action).To(Equal("LinkBillingAccount"))
+				Expect(sink.records[0].Claim).To(Equal(projectReference.Spec.ProjectClaimCRLink.Namespace + "/" + projectReference.Spec.ProjectClaimCRLink.Name))
+				Expect(sink.records[0].ProjectID).To(Equal("fake-gcp-project"))
+				Expect(sink.records[0].Actor).To(Equal(audit.Actor))
+			})
+
+			It("requeues with error and sets the condition if relinking fails", func() {
+				mockGCPClient.EXPECT().GetCloudBillingAccount("fake-gcp-project").Return("billingAccounts/other-account", nil)
+				mockGCPClient.EXPECT().CreateCloudBillingAccount("fake-gcp-project", "desired-account").Return(fakeError)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionBillingReady, corev1.ConditionFalse, "BillingAccountLinkFailed", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := EnsureBillingLinked(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrBillingLinkFailed)).To(BeTrue())
+			})
+		})
+	})
+
+	Context("EnsureProjectLabels", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without touching GCP", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				projectReference.Spec.Labels = map[string]string{"team": "fake-team"}
+				result, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When no labels are requested", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When labels are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.Labels = map[string]string{"team": "fake-team", "env": "integration"}
+			})
+
+			It("applies the labels and records the last-applied hash and keys", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", projectReference.Spec.Labels, []string{}).Return(nil)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				result, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(projectReference.GetAnnotations()[LastAppliedLabelsAnnotation]).NotTo(BeEmpty())
+				Expect(projectReference.GetAnnotations()[LastAppliedLabelKeysAnnotation]).To(Equal("env,team"))
+			})
+
+			It("skips the GCP call when the labels were already applied", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", projectReference.Spec.Labels, []string{}).Return(nil).Times(1)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+
+				result, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("reapplies when the labels change", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", gomock.Any(), []string{}).Return(nil).Times(2)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+
+				projectReference.Spec.Labels["env"] = "production"
+				_, err = EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("reapplies when a label is added", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", gomock.Any(), []string{}).Return(nil).Times(2)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+
+				projectReference.Spec.Labels["cost-center"] = "fake-center"
+				_, err = EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(projectReference.GetAnnotations()[LastAppliedLabelKeysAnnotation]).To(Equal("cost-center,env,team"))
+			})
+
+			It("removes keys dropped from the spec from the project", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", gomock.Any(), []string{}).Return(nil).Times(1)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+
+				delete(projectReference.Spec.Labels, "env")
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", projectReference.Spec.Labels, []string{"env"}).Return(nil).Times(1)
+				_, err = EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(projectReference.GetAnnotations()[LastAppliedLabelKeysAnnotation]).To(Equal("team"))
+			})
+
+			It("removes all managed keys when the spec labels are cleared", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", gomock.Any(), []string{}).Return(nil).Times(1)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+
+				projectReference.Spec.Labels = nil
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", gomock.Any(), []string{"env", "team"}).Return(nil).Times(1)
+				_, err = EnsureProjectLabels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(projectReference.GetAnnotations()).NotTo(HaveKey(LastAppliedLabelKeysAnnotation))
+			})
+
+			It("requeues with error when the GCP call fails", func() {
+				mockGCPClient.EXPECT().SetProjectLabels("fake-gcp-project", projectReference.Spec.Labels, []string{}).Return(fakeError)
+				_, err := EnsureProjectLabels(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureComputeDefaultMetadata", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no default region or zone is requested", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureComputeDefaultMetadata(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a default region and zone are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.DefaultComputeRegion = "us-east1"
+				projectReference.Spec.DefaultComputeZone = "us-east1-b"
+			})
+
+			It("sets the project metadata", func() {
+				mockGCPClient.EXPECT().SetComputeProjectMetadata("fake-gcp-project", map[string]string{
+					"google-compute-default-region": "us-east1",
+					"google-compute-default-zone":   "us-east1-b",
+				}).Return(nil)
+				result, err := EnsureComputeDefaultMetadata(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when the GCP call fails", func() {
+				mockGCPClient.EXPECT().SetComputeProjectMetadata("fake-gcp-project", gomock.Any()).Return(fakeError)
+				_, err := EnsureComputeDefaultMetadata(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When only a default region is requested", func() {
+			It("sets only the region metadata", func() {
+				projectReference.Spec.DefaultComputeRegion = "us-east1"
+				mockGCPClient.EXPECT().SetComputeProjectMetadata("fake-gcp-project", map[string]string{
+					"google-compute-default-region": "us-east1",
+				}).Return(nil)
+				_, err := EnsureComputeDefaultMetadata(adapter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("When the zone lies outside the region", func() {
+			It("requeues with a spec error without touching GCP", func() {
+				projectReference.Spec.DefaultComputeRegion = "us-east1"
+				projectReference.Spec.DefaultComputeZone = "europe-west1-b"
+				_, err := EnsureComputeDefaultMetadata(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidComputeZone)).To(BeTrue())
+			})
+		})
+	})
+
+	Context("EnsureRoleBindings", func() {
+		var existingPolicy *cloudresourcemanager.Policy
+
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+			projectReference.Spec.RoleBindings = []gcpv1alpha1.RoleBinding{
+				{Role: "roles/viewer", Members: []string{"user:listed@example.com"}},
+			}
+			existingPolicy = &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: "roles/viewer", Members: []string{"user:existing@example.com"}},
+					{Role: "roles/owner", Members: []string{"user:admin@example.com"}},
+				},
+			}
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without touching GCP", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				result, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When no role bindings are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.RoleBindings = nil
+			})
+
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("In additive mode", func() {
+			It("adds the listed members and leaves existing members in place", func() {
+				var appliedPolicy *cloudresourcemanager.Policy
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).DoAndReturn(func(request *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error) {
+					appliedPolicy = request.Policy
+					return request.Policy, nil
+				})
+				result, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(appliedPolicy.Bindings[0].Members).To(Equal([]string{"user:existing@example.com", "user:listed@example.com"}))
+				Expect(appliedPolicy.Bindings[1].Members).To(Equal([]string{"user:admin@example.com"}))
+			})
+
+			It("does not update the policy when the bindings are already satisfied", func() {
+				existingPolicy.Bindings[0].Members = []string{"user:listed@example.com"}
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				result, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("creates a missing binding for the requested role", func() {
+				existingPolicy.Bindings = existingPolicy.Bindings[1:]
+				var appliedPolicy *cloudresourcemanager.Policy
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).DoAndReturn(func(request *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error) {
+					appliedPolicy = request.Policy
+					return request.Policy, nil
+				})
+				_, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(appliedPolicy.Bindings[1].Role).To(Equal("roles/viewer"))
+				Expect(appliedPolicy.Bindings[1].Members).To(Equal([]string{"user:listed@example.com"}))
+			})
+		})
+
+		Context("In authoritative mode", func() {
+			BeforeEach(func() {
+				projectReference.Spec.IAMPolicyMode = gcpv1alpha1.IAMPolicyModeAuthoritative
+				existingPolicy.Bindings[0].Members = []string{"user:listed@example.com", "user:unlisted@example.com"}
+			})
+
+			It("removes members not listed in the spec and emits an event for each removal", func() {
+				fakeRecorder := record.NewFakeRecorder(10)
+				adapter.SetEventRecorder(fakeRecorder)
+				var appliedPolicy *cloudresourcemanager.Policy
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).DoAndReturn(func(request *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error) {
+					appliedPolicy = request.Policy
+					return request.Policy, nil
+				})
+				result, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(appliedPolicy.Bindings[0].Members).To(Equal([]string{"user:listed@example.com"}))
+				Expect(<-fakeRecorder.Events).To(ContainSubstring("removed user:unlisted@example.com from roles/viewer"))
+			})
+
+			It("leaves roles not listed in the spec untouched", func() {
+				var appliedPolicy *cloudresourcemanager.Policy
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).DoAndReturn(func(request *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error) {
+					appliedPolicy = request.Policy
+					return request.Policy, nil
+				})
+				_, err := EnsureRoleBindings(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(appliedPolicy.Bindings[1].Members).To(Equal([]string{"user:admin@example.com"}))
+			})
+		})
+
+		Context("When the GCP calls fail", func() {
+			It("requeues with error when the policy cannot be read", func() {
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(nil, fakeError)
+				_, err := EnsureRoleBindings(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("requeues with error when the policy cannot be written", func() {
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(existingPolicy, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, fakeError)
+				_, err := EnsureRoleBindings(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureProjectTagsBound", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no tags are requested", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureProjectTagsBound(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When tags are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.Tags = map[string]string{"environment": "production"}
+			})
+
+			It("resolves the tag values and binds them to the project", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("tagValues/123", nil)
+				mockGCPClient.EXPECT().CreateTagBinding("fake-gcp-project", "tagValues/123").Return(nil)
+				result, err := EnsureProjectTagsBound(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with ErrInvalidTag when a tag does not resolve", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("", fakeError)
+				_, err := EnsureProjectTagsBound(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidTag)).To(BeTrue())
+			})
+
+			It("requeues with error when binding fails", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("tagValues/123", nil)
+				mockGCPClient.EXPECT().CreateTagBinding("fake-gcp-project", "tagValues/123").Return(fakeError)
+				_, err := EnsureProjectTagsBound(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureEssentialContacts", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no essential contacts are requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureEssentialContacts(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When valid essential contacts are requested", func() {
+			It("creates the contacts", func() {
+				projectReference.Spec.EssentialContacts = []gcpv1alpha1.Contact{
+					{Email: "security@example.com", Categories: []string{"SECURITY"}},
+					{Email: "billing@example.com", Categories: []string{"BILLING"}},
+				}
+				mockGCPClient.EXPECT().CreateEssentialContact("fake-gcp-project", "security@example.com", []string{"SECURITY"}).Return(nil)
+				mockGCPClient.EXPECT().CreateEssentialContact("fake-gcp-project", "billing@example.com", []string{"BILLING"}).Return(nil)
+				result, err := EnsureEssentialContacts(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a contact has an invalid email address", func() {
+			It("requeues with error without calling the API", func() {
+				projectReference.Spec.EssentialContacts = []gcpv1alpha1.Contact{
+					{Email: "not-an-email", Categories: []string{"SECURITY"}},
+				}
+				_, err := EnsureEssentialContacts(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidEssentialContact)).To(BeTrue())
+			})
+		})
+
+		Context("When a contact has an unknown category", func() {
+			It("requeues with error without calling the API", func() {
+				projectReference.Spec.EssentialContacts = []gcpv1alpha1.Contact{
+					{Email: "security@example.com", Categories: []string{"NOT_A_CATEGORY"}},
+				}
+				_, err := EnsureEssentialContacts(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidEssentialContact)).To(BeTrue())
+			})
+		})
+
+		Context("When contact creation fails", func() {
+			It("requeues with error and sets the condition", func() {
+				projectReference.Spec.EssentialContacts = []gcpv1alpha1.Contact{
+					{Email: "security@example.com"},
+				}
+				mockGCPClient.EXPECT().CreateEssentialContact("fake-gcp-project", "security@example.com", gomock.Nil()).Return(fakeError)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionContactsReady, corev1.ConditionFalse, "EssentialContactsConfigFailed", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := EnsureEssentialContacts(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("When contact creation succeeds after an earlier failure", func() {
+			It("marks the condition resolved", func() {
+				projectReference.Spec.EssentialContacts = []gcpv1alpha1.Contact{
+					{Email: "security@example.com"},
+				}
+				projectReference.Status.Conditions = []gcpv1alpha1.Condition{
+					{Type: gcpv1alpha1.ConditionContactsReady, Status: corev1.ConditionFalse},
+				}
+				mockGCPClient.EXPECT().CreateEssentialContact("fake-gcp-project", "security@example.com", gomock.Nil()).Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionContactsReady, corev1.ConditionTrue, "EssentialContactsConfigured", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				result, err := EnsureEssentialContacts(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+	})
+
+	Context("EnsureResourceLocationRestricted", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no restriction is requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureResourceLocationRestricted(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When valid locations are requested", func() {
+			It("applies the org policy constraint", func() {
+				projectReference.Spec.ResourceLocationRestriction = []string{"in:eu-locations", "us-east1"}
+				mockGCPClient.EXPECT().SetProjectOrgPolicy("fake-gcp-project", "constraints/gcp.resourceLocations", []string{"in:eu-locations", "us-east1"}).Return(nil)
+				result, err := EnsureResourceLocationRestricted(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When an unknown location is requested", func() {
+			It("requeues with error without calling the API", func() {
+				projectReference.Spec.ResourceLocationRestriction = []string{"moon-base1"}
+				_, err := EnsureResourceLocationRestricted(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidResourceLocation)).To(BeTrue())
+			})
+		})
+
+		Context("When setting the org policy fails", func() {
+			It("requeues with error", func() {
+				projectReference.Spec.ResourceLocationRestriction = []string{"us-east1"}
+				mockGCPClient.EXPECT().SetProjectOrgPolicy("fake-gcp-project", "constraints/gcp.resourceLocations", []string{"us-east1"}).Return(fakeError)
+				_, err := EnsureResourceLocationRestricted(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureTrustedImageProjects", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no trusted image projects are requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureTrustedImageProjects(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When valid projects are requested", func() {
+			It("applies the org policy constraint", func() {
+				projectReference.Spec.TrustedImageProjects = []string{"projects/golden-images", "projects/base-images"}
+				mockGCPClient.EXPECT().SetProjectOrgPolicy("fake-gcp-project", "constraints/compute.trustedImageProjects", []string{"projects/golden-images", "projects/base-images"}).Return(nil)
+				result, err := EnsureTrustedImageProjects(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a malformed project is requested", func() {
+			It("requeues with error without calling the API", func() {
+				projectReference.Spec.TrustedImageProjects = []string{"golden-images"}
+				_, err := EnsureTrustedImageProjects(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, operrors.ErrInvalidTrustedImageProject)).To(BeTrue())
+			})
+		})
+
+		Context("When setting the org policy fails", func() {
+			It("requeues with error", func() {
+				projectReference.Spec.TrustedImageProjects = []string{"projects/golden-images"}
+				mockGCPClient.EXPECT().SetProjectOrgPolicy("fake-gcp-project", "constraints/compute.trustedImageProjects", []string{"projects/golden-images"}).Return(fakeError)
+				_, err := EnsureTrustedImageProjects(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureOSLoginRequired", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When OS Login is not required", func() {
+			It("continues processing without touching the org policy", func() {
+				result, err := EnsureOSLoginRequired(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When OS Login is required", func() {
+			It("enforces the boolean org policy constraint", func() {
+				projectReference.Spec.RequireOSLogin = true
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.requireOsLogin", true).Return(nil)
+				result, err := EnsureOSLoginRequired(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When setting the org policy fails", func() {
+			It("requeues with error", func() {
+				projectReference.Spec.RequireOSLogin = true
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.requireOsLogin", true).Return(fakeError)
+				_, err := EnsureOSLoginRequired(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureSerialPortAccessDisabled", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When serial port access is not restricted", func() {
+			It("continues processing without touching the org policy", func() {
+				result, err := EnsureSerialPortAccessDisabled(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When serial port access is disabled", func() {
+			It("enforces the boolean org policy constraint", func() {
+				projectReference.Spec.DisableSerialPortAccess = true
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.disableSerialPortAccess", true).Return(nil)
+				result, err := EnsureSerialPortAccessDisabled(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When setting the org policy fails", func() {
+			It("requeues with error", func() {
+				projectReference.Spec.DisableSerialPortAccess = true
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.disableSerialPortAccess", true).Return(fakeError)
+				_, err := EnsureSerialPortAccessDisabled(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureServicePerimeterJoined", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no service perimeter is requested", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureServicePerimeterJoined(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a service perimeter is requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.ServicePerimeterName = "accessPolicies/123456789/servicePerimeters/regulated_workloads"
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{ProjectNumber: 1234567890}, nil)
+			})
+
+			It("joins the perimeter and sets the condition", func() {
+				mockGCPClient.EXPECT().AddProjectToServicePerimeter("accessPolicies/123456789/servicePerimeters/regulated_workloads", int64(1234567890)).Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionPerimeterJoined, corev1.ConditionTrue, "PerimeterJoined", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				result, err := EnsureServicePerimeterJoined(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error and sets the condition when the join fails", func() {
+				mockGCPClient.EXPECT().AddProjectToServicePerimeter(gomock.Any(), gomock.Any()).Return(fakeError)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionPerimeterJoined, corev1.ConditionFalse, "PerimeterJoinFailed", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				_, err := EnsureServicePerimeterJoined(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("VerifyAPIsPropagated", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When an API is still propagating", func() {
+			It("requeues until every API reports ENABLED", func() {
+				mockGCPClient.EXPECT().GetServiceState("fake-gcp-project", gomock.Any()).Return("ENABLING", nil)
+				result, err := adapter.VerifyAPIsPropagated()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+
+				mockGCPClient.EXPECT().GetServiceState("fake-gcp-project", gomock.Any()).Return("ENABLED", nil).Times(len(OSDRequiredAPIS))
+				result, err = adapter.VerifyAPIsPropagated()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the enablement state cannot be queried", func() {
+			It("requeues with an error", func() {
+				mockGCPClient.EXPECT().GetServiceState("fake-gcp-project", gomock.Any()).Return("", fakeError)
+				_, err := adapter.VerifyAPIsPropagated()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureDefaultNetworkDeleted", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the claim does not request default network deletion", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureDefaultNetworkDeleted(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the claim requests default network deletion", func() {
+			BeforeEach(func() {
+				projectReference.Spec.DeleteDefaultNetwork = true
+			})
+
+			It("deletes the default network", func() {
+				mockGCPClient.EXPECT().DeleteDefaultNetwork("fake-gcp-project").Return(nil)
+				result, err := EnsureDefaultNetworkDeleted(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when the deletion fails", func() {
+				mockGCPClient.EXPECT().DeleteDefaultNetwork("fake-gcp-project").Return(fakeError)
+				_, err := EnsureDefaultNetworkDeleted(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureDefaultNetworkSkipped", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the claim does not request skipping the default network", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureDefaultNetworkSkipped(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the claim requests skipping the default network", func() {
+			BeforeEach(func() {
+				projectReference.Spec.SkipDefaultNetwork = true
+			})
+
+			It("enforces the org policy, deletes the network and reports completion", func() {
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionDefaultNetworkSkipped).Return(nil, false)
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.skipDefaultNetworkCreation", true).Return(nil)
+				mockGCPClient.EXPECT().DeleteDefaultNetwork("fake-gcp-project").Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionDefaultNetworkSkipped, corev1.ConditionTrue, "DefaultNetworkSkipped", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := EnsureDefaultNetworkSkipped(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("does nothing once completion has been reported", func() {
+				skipped := &gcpv1alpha1.Condition{Type: gcpv1alpha1.ConditionDefaultNetworkSkipped, Status: corev1.ConditionTrue}
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionDefaultNetworkSkipped).Return(skipped, true)
+				result, err := EnsureDefaultNetworkSkipped(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when the org policy cannot be set", func() {
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionDefaultNetworkSkipped).Return(nil, false)
+				mockGCPClient.EXPECT().SetProjectBooleanOrgPolicy("fake-gcp-project", "constraints/compute.skipDefaultNetworkCreation", true).Return(fakeError)
+				_, err := EnsureDefaultNetworkSkipped(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureBaselineFirewallRules", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the claim does not request baseline firewall rules", func() {
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureBaselineFirewallRules(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the claim requests baseline firewall rules", func() {
+			BeforeEach(func() {
+				projectReference.Spec.Network = &gcpv1alpha1.NetworkSpec{BaselineFirewallRules: true}
+			})
+
+			It("creates the allow-internal and allow-iap-ssh rules on the default network", func() {
+				rules := []*compute.Firewall{}
+				mockGCPClient.EXPECT().CreateFirewallRule("fake-gcp-project", gomock.Any()).DoAndReturn(
+					func(projectID string, rule *compute.Firewall) error {
+						rules = append(rules, rule)
+						return nil
+					}).Times(2)
+				result, err := EnsureBaselineFirewallRules(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(rules[0].Name).To(Equal("allow-internal"))
+				Expect(rules[0].Network).To(Equal("projects/fake-gcp-project/global/networks/default"))
+				Expect(rules[1].Name).To(Equal("allow-iap-ssh"))
+				Expect(rules[1].SourceRanges).To(Equal([]string{"35.235.240.0/20"}))
+			})
+
+			It("creates the rules on the configured network", func() {
+				projectReference.Spec.Network.Name = "fake-vpc"
+				rules := []*compute.Firewall{}
+				mockGCPClient.EXPECT().CreateFirewallRule("fake-gcp-project", gomock.Any()).DoAndReturn(
+					func(projectID string, rule *compute.Firewall) error {
+						rules = append(rules, rule)
+						return nil
+					}).Times(2)
+				_, err := EnsureBaselineFirewallRules(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules[0].Network).To(Equal("projects/fake-gcp-project/global/networks/fake-vpc"))
+			})
+
+			It("uses the source ranges configured at operator level", func() {
+				SetBaselineFirewallSourceRanges([]string{"192.168.0.0/16"}, []string{"198.51.100.0/24"})
+				defer SetBaselineFirewallSourceRanges(nil, nil)
+				rules := []*compute.Firewall{}
+				mockGCPClient.EXPECT().CreateFirewallRule("fake-gcp-project", gomock.Any()).DoAndReturn(
+					func(projectID string, rule *compute.Firewall) error {
+						rules = append(rules, rule)
+						return nil
+					}).Times(2)
+				_, err := EnsureBaselineFirewallRules(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules[0].SourceRanges).To(Equal([]string{"192.168.0.0/16"}))
+				Expect(rules[1].SourceRanges).To(Equal([]string{"198.51.100.0/24"}))
+			})
+
+			It("requeues with error when a rule cannot be created", func() {
+				mockGCPClient.EXPECT().CreateFirewallRule("fake-gcp-project", gomock.Any()).Return(fakeError)
+				_, err := EnsureBaselineFirewallRules(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("DescribeTeardown", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Spec.WorkloadIdentity = &gcpv1alpha1.WorkloadIdentity{PoolID: "fake-pool"}
+			projectClaim.Spec.GCPCredentialSecret = gcpv1alpha1.NamespacedName{Name: "fake-secret", Namespace: "fakeNamespace"}
+		})
+
+		It("lists everything a finalization would destroy for a populated reference", func() {
+			Expect(adapter.DescribeTeardown()).To(Equal([]TeardownStep{
+				{Kind: "project", Name: "fake-gcp-project"},
+				{Kind: "serviceAccount", Name: "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"},
+				{Kind: "network", Name: "default"},
+				{Kind: "workloadIdentityPool", Name: "fake-pool"},
+				{Kind: "secret", Name: "fakeNamespace/fake-secret"},
+			}))
+		})
+
+		It("does not list the project for customer-supplied projects", func() {
+			projectReference.Spec.CCS = true
+			Expect(adapter.DescribeTeardown()).To(Equal([]TeardownStep{
+				{Kind: "workloadIdentityPool", Name: "fake-pool"},
+				{Kind: "secret", Name: "fakeNamespace/fake-secret"},
+			}))
+		})
+
+		It("does not list the default network when its deletion was requested", func() {
+			projectReference.Spec.DeleteDefaultNetwork = true
+			Expect(adapter.DescribeTeardown()).NotTo(ContainElement(TeardownStep{Kind: "network", Name: "default"}))
+		})
+
+		It("lists the templated service account when a template is configured", func() {
+			Expect(SetServiceAccountIDTemplate("admin-{{.Name}}")).NotTo(HaveOccurred())
+			defer func() {
+				Expect(SetServiceAccountIDTemplate("")).NotTo(HaveOccurred())
+			}()
+			Expect(adapter.DescribeTeardown()).To(ContainElement(TeardownStep{
+				Kind: "serviceAccount",
+				Name: "admin-fakeprojectclaim@fake-gcp-project.iam.gserviceaccount.com",
+			}))
+		})
+	})
+
+	Context("EnsureTeardownDescribed", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the teardown annotation is disabled", func() {
+			It("continues without publishing anything", func() {
+				result, err := EnsureTeardownDescribed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(projectReference.GetAnnotations()).NotTo(HaveKey(TeardownAnnotation))
+			})
+		})
+
+		Context("When the teardown annotation is enabled", func() {
+			BeforeEach(func() {
+				SetTeardownAnnotationEnabled(true)
+			})
+			AfterEach(func() {
+				SetTeardownAnnotationEnabled(false)
+			})
+
+			It("publishes the teardown description on the reference", func() {
+				mockKubeClient.EXPECT().Update(gomock.Any(), projectReference)
+				result, err := EnsureTeardownDescribed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(projectReference.GetAnnotations()[TeardownAnnotation]).To(ContainSubstring(`"kind":"project"`))
+				Expect(projectReference.GetAnnotations()[TeardownAnnotation]).To(ContainSubstring("fake-gcp-project"))
+			})
+
+			It("does not update the reference when the annotation is current", func() {
+				mockKubeClient.EXPECT().Update(gomock.Any(), projectReference).Times(1)
+				_, err := EnsureTeardownDescribed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				result, err := EnsureTeardownDescribed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+	})
+
+	Context("EnsureIAMPolicyHealthy", func() {
+		managedMember := "serviceAccount:osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"
+		var healthyPolicy *cloudresourcemanager.Policy
+		var driftedPolicy *cloudresourcemanager.Policy
+
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+			healthyPolicy = &cloudresourcemanager.Policy{}
+			for _, role := range OSDRequiredRoles {
+				healthyPolicy.Bindings = append(healthyPolicy.Bindings, &cloudresourcemanager.Binding{Role: role, Members: []string{managedMember}})
+			}
+			driftedPolicy = &cloudresourcemanager.Policy{}
+			for _, role := range OSDRequiredRoles[1:] {
+				driftedPolicy.Bindings = append(driftedPolicy.Bindings, &cloudresourcemanager.Binding{Role: role, Members: []string{managedMember}})
+			}
+			driftedPolicy.Bindings[0].Members = append(driftedPolicy.Bindings[0].Members, "user:intruder@example.com")
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without querying the policy", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				result, err := EnsureIAMPolicyHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the policy matches the managed bindings", func() {
+			It("continues processing without touching the conditions", func() {
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(healthyPolicy, nil)
+				result, err := EnsureIAMPolicyHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the policy drifted from the managed bindings", func() {
+			BeforeEach(func() {
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionIAMDrift, corev1.ConditionTrue, "IAMDrift", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+			})
+
+			It("sets the IAMDrift condition", func() {
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(driftedPolicy, nil)
+				result, err := EnsureIAMPolicyHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			Context("When drift repair is enabled", func() {
+				BeforeEach(func() {
+					SetIAMDriftRepair(true)
+				})
+				AfterEach(func() {
+					SetIAMDriftRepair(false)
+				})
+				It("re-applies the managed bindings", func() {
+					mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(driftedPolicy, nil).Times(2)
+					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(healthyPolicy, nil).Times(1)
+					result, err := EnsureIAMPolicyHealthy(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(continueProcessingResult))
+				})
+			})
+		})
+
+		Context("When a previously reported drift is gone", func() {
+			It("marks the IAMDrift condition resolved", func() {
+				projectReference.Status.Conditions = []gcpv1alpha1.Condition{{Type: gcpv1alpha1.ConditionIAMDrift, Status: corev1.ConditionTrue}}
+				mockGCPClient.EXPECT().GetIamPolicy("fake-gcp-project").Return(healthyPolicy, nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionIAMDrift, corev1.ConditionFalse, "IAMDriftResolved", "managed IAM bindings match the desired state again").Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := EnsureIAMPolicyHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+	})
+
+	Context("EnsureServiceAccountHealthy", func() {
+		notFoundError := errors.New("googleapi: Error 404: Service account does not exist")
+
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without querying GCP", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				result, err := EnsureServiceAccountHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the service account still exists", func() {
+			It("continues processing", func() {
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(&iam.ServiceAccount{Email: "foo"}, nil)
+				result, err := EnsureServiceAccountHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the service account was deleted out-of-band", func() {
+			It("recreates the account, a fresh key and the credentials secret", func() {
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(nil, notFoundError).Times(2)
+				mockGCPClient.EXPECT().CreateServiceAccount(gomock.Any(), gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+				// The stale secret holding the deleted account's key is removed
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionFalse, "CredentialsSecretPending", gomock.Any()).Times(2)
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(&iam.ServiceAccount{Email: "foo"}, nil)
+				mockGCPClient.EXPECT().CreateServiceAccountKey("foo").Return(&iam.ServiceAccountKey{PrivateKeyData: "YWRtaW4="}, nil)
+				mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionTrue, "CredentialsSecretCreated", gomock.Any()).Times(2)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(4)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+				result, err := EnsureServiceAccountHealthy(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			Context("When the claim opts out of the credentials secret", func() {
+				It("recreates the account but no key or secret", func() {
+					projectReference.Spec.SkipCredentialsSecret = true
+					mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(nil, notFoundError).Times(2)
+					mockGCPClient.EXPECT().CreateServiceAccount(gomock.Any(), gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
+					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+					result, err := EnsureServiceAccountHealthy(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(continueProcessingResult))
+				})
+			})
+		})
+
+		Context("When the service account cannot be verified", func() {
+			It("requeues with error", func() {
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(nil, fakeError)
+				_, err := EnsureServiceAccountHealthy(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureOperatorImpersonation", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no impersonation member is configured", func() {
+			It("continues processing", func() {
+				result, err := EnsureOperatorImpersonation(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When an impersonation member is configured", func() {
+			const operatorMember = "serviceAccount:operator@operator-project.iam.gserviceaccount.com"
+
+			BeforeEach(func() {
+				SetOperatorImpersonationMember(operatorMember)
+			})
+			AfterEach(func() {
+				SetOperatorImpersonationMember("")
+			})
+
+			It("binds the operator as token creator on the project service account", func() {
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(&iam.ServiceAccount{Email: "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockGCPClient.EXPECT().BindServiceAccountTokenCreator("fake-gcp-project", "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com", operatorMember).Return(nil)
+				result, err := EnsureOperatorImpersonation(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when the binding fails", func() {
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(&iam.ServiceAccount{Email: "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockGCPClient.EXPECT().BindServiceAccountTokenCreator(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError)
+				_, err := EnsureOperatorImpersonation(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			Context("When a service account ID template is configured", func() {
+				AfterEach(func() {
+					Expect(SetServiceAccountIDTemplate("")).NotTo(HaveOccurred())
+				})
+
+				It("renders the template over the claim and lowercases the result", func() {
+					Expect(SetServiceAccountIDTemplate("admin-{{.Name}}")).NotTo(HaveOccurred())
+					mockGCPClient.EXPECT().GetServiceAccount("admin-fakeprojectclaim").Return(&iam.ServiceAccount{Email: "admin-fakeprojectclaim@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+					mockGCPClient.EXPECT().BindServiceAccountTokenCreator(gomock.Any(), gomock.Any(), operatorMember).Return(nil)
+					_, err := EnsureOperatorImpersonation(adapter)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("truncates rendered IDs to GCP's maximum length", func() {
+					Expect(SetServiceAccountIDTemplate("abcdefghijklmnopqrstuvwxyz-abcdefghijklmnop")).NotTo(HaveOccurred())
+					mockGCPClient.EXPECT().GetServiceAccount("abcdefghijklmnopqrstuvwxyz-abc").Return(&iam.ServiceAccount{Email: "fake@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+					mockGCPClient.EXPECT().BindServiceAccountTokenCreator(gomock.Any(), gomock.Any(), operatorMember).Return(nil)
+					_, err := EnsureOperatorImpersonation(adapter)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("rejects rendered IDs that violate GCP's constraints", func() {
+					Expect(SetServiceAccountIDTemplate("x")).NotTo(HaveOccurred())
+					_, err := EnsureOperatorImpersonation(adapter)
+					Expect(errors.Is(err, operrors.ErrInvalidServiceAccountID)).To(BeTrue())
+				})
+
+				It("rejects malformed templates", func() {
+					Expect(SetServiceAccountIDTemplate("{{.Name")).To(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	Context("EnsureNotificationChannels", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no channels are requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureNotificationChannels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When channels are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.NotificationChannels = []gcpv1alpha1.NotificationChannel{
+					{Type: "email", DisplayName: "team-alerts", Labels: map[string]string{"email_address": "team@example.com"}},
+					{Type: "slack", DisplayName: "team-slack"},
+				}
+			})
+
+			It("creates the channels and records their IDs on the status", func() {
+				mockGCPClient.EXPECT().CreateNotificationChannel("fake-gcp-project", "email", "team-alerts", map[string]string{"email_address": "team@example.com"}).Return("projects/fake-gcp-project/notificationChannels/1", nil)
+				mockGCPClient.EXPECT().CreateNotificationChannel("fake-gcp-project", "slack", "team-slack", nil).Return("projects/fake-gcp-project/notificationChannels/2", nil)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+				result, err := EnsureNotificationChannels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(projectReference.Status.NotificationChannelIDs).To(Equal([]string{
+					"projects/fake-gcp-project/notificationChannels/1",
+					"projects/fake-gcp-project/notificationChannels/2",
+				}))
+			})
+
+			It("does not update the status when the IDs are unchanged", func() {
+				projectReference.Status.NotificationChannelIDs = []string{
+					"projects/fake-gcp-project/notificationChannels/1",
+					"projects/fake-gcp-project/notificationChannels/2",
+				}
+				mockGCPClient.EXPECT().CreateNotificationChannel("fake-gcp-project", "email", "team-alerts", gomock.Any()).Return("projects/fake-gcp-project/notificationChannels/1", nil)
+				mockGCPClient.EXPECT().CreateNotificationChannel("fake-gcp-project", "slack", "team-slack", gomock.Any()).Return("projects/fake-gcp-project/notificationChannels/2", nil)
+
+				result, err := EnsureNotificationChannels(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when channel creation fails", func() {
+				mockGCPClient.EXPECT().CreateNotificationChannel("fake-gcp-project", "email", "team-alerts", gomock.Any()).Return("", fakeError)
+				_, err := EnsureNotificationChannels(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureProjectNumberPropagated", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the project has no ID yet", func() {
+			BeforeEach(func() {
+				projectReference.Spec.GCPProjectID = ""
+			})
+
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureProjectNumberPropagated(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the project was created", func() {
+			It("records the project number and mirrors it to the claim", func() {
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{ProjectNumber: 1234567890}, nil)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(2)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				result, err := EnsureProjectNumberPropagated(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(adapter.ProjectReference.Status.GCPProjectNumber).To(Equal(int64(1234567890)))
+				Expect(adapter.ProjectClaim.Status.GCPProjectNumber).To(Equal(int64(1234567890)))
+			})
+		})
+
+		Context("When the project number is not queryable yet", func() {
+			It("continues processing and retries on a later reconcile", func() {
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(nil, fakeError)
+				result, err := EnsureProjectNumberPropagated(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(adapter.ProjectReference.Status.GCPProjectNumber).To(Equal(int64(0)))
+			})
+		})
+
+		Context("When the number was already propagated", func() {
+			It("does nothing", func() {
+				adapter.ProjectReference.Status.GCPProjectNumber = 1234567890
+				adapter.ProjectClaim.Status.GCPProjectNumber = 1234567890
+				result, err := EnsureProjectNumberPropagated(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+	})
+
+	Context("EnsureServiceAccountEmailSurfaced", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When the project has no ID yet", func() {
+			BeforeEach(func() {
+				projectReference.Spec.GCPProjectID = ""
+			})
+
+			It("continues processing without touching GCP", func() {
+				result, err := EnsureServiceAccountEmailSurfaced(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the service account was created", func() {
+			It("records the email and mirrors it to the claim", func() {
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(2)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				result, err := EnsureServiceAccountEmailSurfaced(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(adapter.ProjectReference.Status.ServiceAccountEmail).To(Equal("osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"))
+				Expect(adapter.ProjectClaim.Status.ServiceAccountEmail).To(Equal("osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"))
+			})
+		})
+
+		Context("When the service account is not visible yet", func() {
+			It("continues processing and retries on a later reconcile", func() {
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(nil, fakeError)
+				result, err := EnsureServiceAccountEmailSurfaced(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+				Expect(adapter.ProjectReference.Status.ServiceAccountEmail).To(BeEmpty())
+			})
+		})
+
+		Context("When the email was already surfaced", func() {
+			It("does nothing", func() {
+				adapter.ProjectReference.Status.ServiceAccountEmail = "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"
+				adapter.ProjectClaim.Status.ServiceAccountEmail = "osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"
+				result, err := EnsureServiceAccountEmailSurfaced(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+	})
+
+	Context("EnsureProjectParent", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without querying the project", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				projectReference.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+				result, err := EnsureProjectParent(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When no parent is requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureProjectParent(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a parent is requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+			})
+
+			Context("and the project is already under it", func() {
+				JustBeforeEach(func() {
+					mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{
+						Parent: &cloudresourcemanager.ResourceId{Type: "folder", Id: "1234567890"},
+					}, nil)
+				})
+
+				It("continues without moving the project", func() {
+					mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionProjectMoving).Return(&gcpv1alpha1.Condition{}, false)
+					result, err := EnsureProjectParent(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(continueProcessingResult))
+				})
+
+				It("clears the ProjectMoving condition after a completed move", func() {
+					mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionProjectMoving).Return(&gcpv1alpha1.Condition{Status: corev1.ConditionTrue}, true)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionProjectMoving, corev1.ConditionFalse, "ProjectMoveComplete", gomock.Any())
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+					result, err := EnsureProjectParent(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(continueProcessingResult))
+				})
+			})
+
+			Context("and the project is under a different parent", func() {
+				JustBeforeEach(func() {
+					mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{
+						Parent: &cloudresourcemanager.ResourceId{Type: "folder", Id: "9999999999"},
+					}, nil)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionProjectMoving, corev1.ConditionTrue, "ProjectMoveInProgress", gomock.Any())
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				})
+
+				It("moves the project and requeues to verify completion", func() {
+					mockGCPClient.EXPECT().MoveProject("fake-gcp-project", "folder", "1234567890").Return(nil)
+					result, err := EnsureProjectParent(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.RequeueRequest).To(BeTrue())
+					Expect(result.RequeueDelay).To(Equal(30 * time.Second))
+				})
+
+				It("requeues with error when the move fails", func() {
+					mockGCPClient.EXPECT().MoveProject("fake-gcp-project", "folder", "1234567890").Return(fakeError)
+					_, err := EnsureProjectParent(adapter)
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	Context("EnsureProjectDisplayName", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+		})
+
+		Context("When the reference is not Ready yet", func() {
+			It("continues processing without updating the display name", func() {
+				projectReference.Status.State = api.ProjectReferenceStatusCreating
+				result, err := EnsureProjectDisplayName(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When no display name is set", func() {
+			It("applies the claim name as display name", func() {
+				mockGCPClient.EXPECT().UpdateProjectDisplayName("fake-gcp-project", projectClaim.GetName()).Return(nil)
+				result, err := EnsureProjectDisplayName(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When an explicit display name is set", func() {
+			BeforeEach(func() {
+				projectReference.Spec.DisplayName = "My Fancy Project"
+			})
+
+			It("applies the requested display name", func() {
+				mockGCPClient.EXPECT().UpdateProjectDisplayName("fake-gcp-project", "My Fancy Project").Return(nil)
+				result, err := EnsureProjectDisplayName(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when the update fails", func() {
+				mockGCPClient.EXPECT().UpdateProjectDisplayName("fake-gcp-project", "My Fancy Project").Return(fakeError)
+				result, err := EnsureProjectDisplayName(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+			})
+		})
+	})
+
+	Context("EnsureWorkloadIdentityConfigured", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no workload identity is requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When a workload identity is requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.WorkloadIdentity = &gcpv1alpha1.WorkloadIdentity{
+					PoolID: "fake-pool",
+					Provider: gcpv1alpha1.WorkloadIdentityProvider{
+						ID:               "fake-provider",
+						IssuerURI:        "https://issuer.example.com",
+						AllowedAudiences: []string{"fake-audience"},
+					},
+				}
+			})
+
+			It("creates the pool and provider, binds the service account and reports readiness", func() {
+				mockGCPClient.EXPECT().CreateWorkloadIdentityPool("fake-gcp-project", "fake-pool").Return(nil)
+				mockGCPClient.EXPECT().CreateWorkloadIdentityProvider("fake-gcp-project", "fake-pool", "fake-provider", "https://issuer.example.com", []string{"fake-audience"}).Return(nil)
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{ProjectNumber: 123456}, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "sa@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockGCPClient.EXPECT().BindWorkloadIdentityUser("fake-gcp-project", "sa@fake-gcp-project.iam.gserviceaccount.com", "principalSet://iam.googleapis.com/projects/123456/locations/global/workloadIdentityPools/fake-pool/*").Return(nil)
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionWorkloadIdentityReady).Return(nil, false).Times(1)
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionWorkloadIdentityReady, corev1.ConditionTrue, "WorkloadIdentityConfigured", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				result, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("does not rewrite the condition once it is already True", func() {
+				mockGCPClient.EXPECT().CreateWorkloadIdentityPool("fake-gcp-project", "fake-pool").Return(nil)
+				mockGCPClient.EXPECT().CreateWorkloadIdentityProvider("fake-gcp-project", "fake-pool", "fake-provider", "https://issuer.example.com", []string{"fake-audience"}).Return(nil)
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{ProjectNumber: 123456}, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "sa@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockGCPClient.EXPECT().BindWorkloadIdentityUser("fake-gcp-project", "sa@fake-gcp-project.iam.gserviceaccount.com", gomock.Any()).Return(nil)
+				readyCondition := gcpv1alpha1.Condition{Type: gcpv1alpha1.ConditionWorkloadIdentityReady, Status: corev1.ConditionTrue}
+				mockConditions.EXPECT().FindCondition(gomock.Any(), gcpv1alpha1.ConditionWorkloadIdentityReady).Return(&readyCondition, true).Times(1)
+				result, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when pool creation fails", func() {
+				mockGCPClient.EXPECT().CreateWorkloadIdentityPool("fake-gcp-project", "fake-pool").Return(fakeError)
+				_, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("requeues with error when provider creation fails", func() {
+				mockGCPClient.EXPECT().CreateWorkloadIdentityPool("fake-gcp-project", "fake-pool").Return(nil)
+				mockGCPClient.EXPECT().CreateWorkloadIdentityProvider("fake-gcp-project", "fake-pool", "fake-provider", "https://issuer.example.com", []string{"fake-audience"}).Return(fakeError)
+				_, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("requeues with error when the binding fails", func() {
+				mockGCPClient.EXPECT().CreateWorkloadIdentityPool("fake-gcp-project", "fake-pool").Return(nil)
+				mockGCPClient.EXPECT().CreateWorkloadIdentityProvider("fake-gcp-project", "fake-pool", "fake-provider", "https://issuer.example.com", []string{"fake-audience"}).Return(nil)
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{ProjectNumber: 123456}, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "sa@fake-gcp-project.iam.gserviceaccount.com"}, nil)
+				mockGCPClient.EXPECT().BindWorkloadIdentityUser("fake-gcp-project", "sa@fake-gcp-project.iam.gserviceaccount.com", gomock.Any()).Return(fakeError)
+				_, err := EnsureWorkloadIdentityConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("EnsureKMSConfigured", func() {
+		BeforeEach(func() {
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+		})
+
+		Context("When no keyrings are requested", func() {
+			It("continues processing", func() {
+				result, err := EnsureKMSConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When keyrings are requested", func() {
+			BeforeEach(func() {
+				projectReference.Spec.KMSKeyRings = []gcpv1alpha1.KMSKeyRingSpec{
+					{
+						Name:     "fake-keyring",
+						Location: "global",
+						KeyNames: []string{"fake-key", "other-key"},
+					},
+				}
+			})
+
+			It("enables the KMS API and creates the keyring and its keys", func() {
+				mockGCPClient.EXPECT().EnableAPI("fake-gcp-project", "cloudkms.googleapis.com").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSKeyRing("fake-gcp-project", "global", "fake-keyring").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSCryptoKey("fake-gcp-project", "global", "fake-keyring", "fake-key", time.Duration(0)).Return(nil)
+				mockGCPClient.EXPECT().CreateKMSCryptoKey("fake-gcp-project", "global", "fake-keyring", "other-key", time.Duration(0)).Return(nil)
+				result, err := EnsureKMSConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("passes the rotation period through to key creation", func() {
+				projectReference.Spec.KMSKeyRings[0].KeyNames = []string{"fake-key"}
+				projectReference.Spec.KMSKeyRings[0].RotationPeriod = &metav1.Duration{Duration: 90 * 24 * time.Hour}
+				mockGCPClient.EXPECT().EnableAPI("fake-gcp-project", "cloudkms.googleapis.com").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSKeyRing("fake-gcp-project", "global", "fake-keyring").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSCryptoKey("fake-gcp-project", "global", "fake-keyring", "fake-key", 90*24*time.Hour).Return(nil)
+				result, err := EnsureKMSConfigured(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+
+			It("requeues with error when enabling the KMS API fails", func() {
+				mockGCPClient.EXPECT().EnableAPI("fake-gcp-project", "cloudkms.googleapis.com").Return(fakeError)
+				_, err := EnsureKMSConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("requeues with error when keyring creation fails", func() {
+				mockGCPClient.EXPECT().EnableAPI("fake-gcp-project", "cloudkms.googleapis.com").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSKeyRing("fake-gcp-project", "global", "fake-keyring").Return(fakeError)
+				_, err := EnsureKMSConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("requeues with error when key creation fails", func() {
+				mockGCPClient.EXPECT().EnableAPI("fake-gcp-project", "cloudkms.googleapis.com").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSKeyRing("fake-gcp-project", "global", "fake-keyring").Return(nil)
+				mockGCPClient.EXPECT().CreateKMSCryptoKey("fake-gcp-project", "global", "fake-keyring", "fake-key", time.Duration(0)).Return(fakeError)
+				_, err := EnsureKMSConfigured(adapter)
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 
@@ -382,9 +2166,93 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 							Expect(err).To(HaveOccurred())
 							Expect(strings.Contains(err.Error(), "could not clear project ID")).To(BeFalse())
 							Expect(strings.Contains(err.Error(), "could not create project. Parent Folder ID")).To(BeTrue())
+							Expect(errors.Is(err, operrors.ErrProjectIDTaken)).To(BeTrue())
+						})
+					})
+
+					Context("When GCP reports the creation quota is exhausted", func() {
+						It("sets the quota condition and requeues after a long delay", func() {
+							mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+							mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: "foo"}}, nil)
+							mockGCPClient.EXPECT().CreateProject(gomock.Any()).Return(nil, &googleapi.Error{Code: 429, Message: "Quota exceeded"})
+							mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionError, corev1.ConditionTrue, ReasonProjectQuotaExhausted, gomock.Any()).Times(1)
+							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+							result, err := EnsureProjectCreated(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result.RequeueRequest).To(BeTrue())
+							Expect(result.RequeueDelay).To(Equal(30 * time.Minute))
+						})
+					})
+				})
+
+				Context("When a parent is requested", func() {
+					BeforeEach(func() {
+						projectReference.Spec.Parent = &api.ProjectParent{Type: api.ParentTypeFolder, ID: "12345"}
+					})
+
+					Context("When the operator cannot create projects under the parent", func() {
+						It("sets the ParentAccessDenied condition before any create call", func() {
+							mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+							mockGCPClient.EXPECT().TestParentIamPermissions("folder", "12345", []string{"resourcemanager.projects.create"}).Return([]string{}, nil)
+							mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionError, corev1.ConditionTrue, "ParentAccessDenied", gomock.Any()).Times(1)
+							mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+							mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+							result, err := EnsureProjectCreated(adapter)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result.RequeueRequest).To(BeTrue())
+						})
+					})
+
+					Context("When the permission check itself fails", func() {
+						It("requeues with error", func() {
+							mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+							mockGCPClient.EXPECT().TestParentIamPermissions("folder", "12345", gomock.Any()).Return(nil, fakeError)
+							_, err := EnsureProjectCreated(adapter)
+							Expect(err).To(HaveOccurred())
+						})
+					})
+
+					Context("When the operator holds the create permission", func() {
+						It("proceeds to create the project", func() {
+							mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+							mockGCPClient.EXPECT().TestParentIamPermissions("folder", "12345", gomock.Any()).Return([]string{"resourcemanager.projects.create"}, nil)
+							mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: projectReference.Spec.GCPProjectID}}, nil)
+							mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(nil, fakeError)
+							_, err := EnsureProjectCreated(adapter)
+							Expect(err).To(HaveOccurred())
 						})
 					})
 				})
+
+				Context("When the creation deadline has passed", func() {
+					BeforeEach(func() {
+						projectReference.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+					})
+
+					It("raises the ProjectCreateTimeout condition and requeues with error", func() {
+						mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+						mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: "foo"}}, nil)
+						mockGCPClient.EXPECT().CreateProject(gomock.Any()).Return(nil, fakeError)
+						mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+						mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionError, corev1.ConditionTrue, "ProjectCreateTimeout", gomock.Any()).Times(1)
+						mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+						mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+						_, err := EnsureProjectCreated(adapter)
+						Expect(err).To(HaveOccurred())
+					})
+
+					It("does not raise the condition within a configured longer deadline", func() {
+						SetProjectCreateTimeout(2 * time.Hour)
+						defer SetProjectCreateTimeout(0)
+						mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+						mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: "foo"}}, nil)
+						mockGCPClient.EXPECT().CreateProject(gomock.Any()).Return(nil, fakeError)
+						mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+						_, err := EnsureProjectCreated(adapter)
+						Expect(err).To(HaveOccurred())
+					})
+				})
 			})
 
 			Context("When it fails to configure Billing API", func() {
@@ -423,6 +2291,54 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 				})
 
 			})
+
+			Context("When the project and billing are configured successfully", func() {
+				JustBeforeEach(func() {
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap)
+					mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: projectReference.Spec.GCPProjectID}}, nil)
+					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return([]string{"cloudbilling.googleapis.com"}, nil)
+					mockGCPClient.EXPECT().CreateCloudBillingAccount(gomock.Any(), gomock.Any()).Return(nil)
+				})
+
+				Context("When the create and billing phases are in flight", func() {
+					BeforeEach(func() {
+						projectReference.Status.PhaseStartTimes = map[string]metav1.Time{
+							"create":  metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+							"billing": metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+						}
+					})
+
+					It("records the phase durations on completion", func() {
+						result, err := EnsureProjectCreated(adapter)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(Equal(continueProcessingResult))
+						Expect(projectReference.Status.PhaseStartTimes).NotTo(HaveKey("create"))
+						Expect(projectReference.Status.PhaseDurations["create"].Duration).To(BeNumerically("~", 2*time.Minute, 10*time.Second))
+						Expect(projectReference.Status.PhaseDurations["billing"].Duration).To(BeNumerically("~", 2*time.Minute, 10*time.Second))
+						metric := &dto.Metric{}
+						Expect(localmetrics.ProvisioningPhaseDurationSeconds.WithLabelValues("create").(prometheus.Metric).Write(metric)).To(Succeed())
+						Expect(metric.GetHistogram().GetSampleCount()).To(BeNumerically(">", 0))
+						Expect(metric.GetHistogram().GetSampleSum()).To(BeNumerically(">=", 110))
+					})
+				})
+
+				Context("When the phases already completed in an earlier reconcile", func() {
+					BeforeEach(func() {
+						projectReference.Status.PhaseDurations = map[string]metav1.Duration{
+							"create":  {Duration: time.Minute},
+							"billing": {Duration: time.Minute},
+						}
+					})
+
+					It("neither restarts them nor overwrites their durations", func() {
+						_, err := EnsureProjectCreated(adapter)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(projectReference.Status.PhaseStartTimes).To(BeEmpty())
+						Expect(projectReference.Status.PhaseDurations["create"].Duration).To(Equal(time.Minute))
+						Expect(projectReference.Status.PhaseDurations["billing"].Duration).To(Equal(time.Minute))
+					})
+				})
+			})
 		})
 
 	})
@@ -431,6 +2347,7 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 		JustBeforeEach(func() {
 			projectReference.Spec.GCPProjectID = "Some fake id"
 			projectReference.Status.State = api.ProjectReferenceStatusCreating
+			mockGCPClient.EXPECT().GetServiceState(gomock.Any(), gomock.Any()).Return("ENABLED", nil).AnyTimes()
 		})
 
 		Context("When it fails to configure APIS", func() {
@@ -507,6 +2424,12 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 		})
 
 		Context("When it fails to create credentials", func() {
+			JustBeforeEach(func() {
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionFalse, "CredentialsSecretPending", gomock.Any()).Times(2)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(2)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			})
+
 			Context("When it fails to get Service Account", func() {
 				It("It requeues with error", func() {
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
@@ -550,29 +2473,83 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 			})
 		})
 
+		Context("When the claim opts out of the credentials secret", func() {
+			It("does not create a key or secret and continues processing", func() {
+				projectReference.Spec.SkipCredentialsSecret = true
+				mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+				_, err := EnsureProjectConfigured(adapter)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("When it create credentials successfully", func() {
-			Context("Credential Secret already exists", func() {
-				It("Continue execute", func() {
+			Context("Credential Secret already exists with valid key material", func() {
+				It("Marks the credentials ready and continues execute", func() {
+					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
+					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+						Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata")},
+					})
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionTrue, "CredentialsSecretValid", gomock.Any()).Times(2)
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(2)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+					_, err := EnsureProjectConfigured(adapter)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("Credential Secret already exists and the condition is already True", func() {
+				It("Continue execute without touching the condition", func() {
+					projectReference.Status.Conditions = []gcpv1alpha1.Condition{
+						{Type: gcpv1alpha1.ConditionCredentialsReady, Status: corev1.ConditionTrue},
+					}
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
-					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+						Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata")},
+					})
 					_, err := EnsureProjectConfigured(adapter)
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 
+			Context("Credential Secret exists without key material", func() {
+				It("Marks the credentials not ready and requeues with error", func() {
+					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
+					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{})
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionFalse, "CredentialsSecretInvalid", gomock.Any()).Times(2)
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(2)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+					_, err := EnsureProjectConfigured(adapter)
+					Expect(err).To(HaveOccurred())
+					Expect(errors.Is(err, operrors.ErrInvalidCredentialsSecret)).To(BeTrue())
+				})
+			})
+
 			Context("Create a secret successfully", func() {
-				It("Continue execute", func() {
+				It("Marks the credentials ready and continues execute", func() {
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionFalse, "CredentialsSecretPending", gomock.Any()).Times(2)
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "foo"}, nil)
 					mockGCPClient.EXPECT().CreateServiceAccountKey(gomock.Any()).Return(&iam.ServiceAccountKey{PrivateKeyData: "YWRtaW4="}, nil)
 					mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionCredentialsReady, corev1.ConditionTrue, "CredentialsSecretCreated", gomock.Any()).Times(2)
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(4)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(4)
 					_, err := EnsureProjectConfigured(adapter)
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -708,6 +2685,277 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+		Context("When the claim requests credentials retention", func() {
+			BeforeEach(func() {
+				projectReference.Spec.CCS = true
+				projectClaim.Spec.CredentialsRetention = &metav1.Duration{Duration: time.Hour}
+			})
+			It("marks the secret for deferred deletion instead of deleting it", func() {
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				var retained corev1.Secret
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj runtime.Object) error {
+					retained = *obj.(*corev1.Secret)
+					return nil
+				})
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retained.GetAnnotations()).To(HaveKey(CredentialsDeleteAfterAnnotation))
+			})
+			It("leaves an already marked secret untouched", func() {
+				marked := corev1.Secret{}
+				marked.SetAnnotations(map[string]string{CredentialsDeleteAfterAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)})
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, marked).Times(2)
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When tags were bound to the project", func() {
+			BeforeEach(func() {
+				projectReference.Spec.CCS = true
+				projectReference.Spec.Tags = map[string]string{"environment": "production"}
+			})
+			It("unbinds the tag values", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("tagValues/123", nil)
+				mockGCPClient.EXPECT().DeleteTagBinding("fake-id", "tagValues/123").Return(nil)
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("tolerates tag values that no longer resolve", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("", fakeError)
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("returns an error when unbinding fails", func() {
+				mockGCPClient.EXPECT().LookupTagValue("environment", "production").Return("tagValues/123", nil)
+				mockGCPClient.EXPECT().DeleteTagBinding("fake-id", "tagValues/123").Return(fakeError)
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When a workload identity pool was provisioned", func() {
+			BeforeEach(func() {
+				projectReference.Spec.CCS = true
+				projectReference.Spec.WorkloadIdentity = &gcpv1alpha1.WorkloadIdentity{PoolID: "fake-pool"}
+			})
+			It("deletes the pool", func() {
+				mockGCPClient.EXPECT().DeleteWorkloadIdentityPool("fake-id", "fake-pool").Return(nil)
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("returns an error when the pool cannot be deleted", func() {
+				mockGCPClient.EXPECT().DeleteWorkloadIdentityPool("fake-id", "fake-pool").Return(fakeError)
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("When KMS keyrings were provisioned", func() {
+			BeforeEach(func() {
+				projectReference.Spec.CCS = true
+				projectReference.Spec.KMSKeyRings = []gcpv1alpha1.KMSKeyRingSpec{
+					{Name: "fake-keyring", Location: "global", KeyNames: []string{"fake-key"}},
+				}
+			})
+			It("skips the keyrings and notes their retention in a condition", func() {
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionKMSKeyRingsRetained, corev1.ConditionTrue, "KeyRingsNotDeletable", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any())
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("finishes finalization even when the status write fails", func() {
+				mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionKMSKeyRingsRetained, corev1.ConditionTrue, "KeyRingsNotDeletable", gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fakeError)
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any())
+				err := adapter.EnsureProjectCleanedUp()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Context("SweepRetainedCredentials", func() {
+		newRetainedSecret := func(name, deleteAfter string) corev1.Secret {
+			secret := corev1.Secret{}
+			secret.SetName(name)
+			if deleteAfter != "" {
+				secret.SetAnnotations(map[string]string{CredentialsDeleteAfterAnnotation: deleteAfter})
+			}
+			return secret
+		}
+
+		It("retains secrets whose window has not passed yet", func() {
+			secretList := corev1.SecretList{Items: []corev1.Secret{
+				newRetainedSecret("retained", time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+				newRetainedSecret("unmarked", ""),
+			}}
+			mockKubeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, secretList)
+			swept, err := SweepRetainedCredentials(context.TODO(), mockKubeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(swept).To(Equal(0))
+		})
+
+		It("sweeps secrets whose window has passed", func() {
+			secretList := corev1.SecretList{Items: []corev1.Secret{
+				newRetainedSecret("expired", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)),
+				newRetainedSecret("retained", time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+			}}
+			mockKubeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, secretList)
+			mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			swept, err := SweepRetainedCredentials(context.TODO(), mockKubeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(swept).To(Equal(1))
+		})
+	})
+
+	Context("EnsureDeletionProcessed", func() {
+		Context("When finalization keeps failing", func() {
+			BeforeEach(func() {
+				deletionTime := metav1.Now()
+				projectReference.SetDeletionTimestamp(&deletionTime)
+				projectReference.Spec.GCPProjectID = "fake-id"
+				projectReference.Spec.CCS = true
+				projectReference.Spec.WorkloadIdentity = &gcpv1alpha1.WorkloadIdentity{PoolID: "fake-pool"}
+			})
+
+			It("backs off exponentially between retries", func() {
+				mockGCPClient.EXPECT().DeleteWorkloadIdentityPool("fake-id", "fake-pool").Return(fakeError).Times(3)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter).Times(3)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+				delays := []time.Duration{}
+				for i := 0; i < 3; i++ {
+					result, err := EnsureDeletionProcessed(adapter)
+					Expect(err).To(HaveOccurred())
+					Expect(result.RequeueRequest).To(BeTrue())
+					delays = append(delays, result.RequeueDelay)
+				}
+				Expect(delays).To(Equal([]time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second}))
+			})
+
+			It("does not back off beyond the cap", func() {
+				projectReference.Status.FinalizationRetries = 30
+				mockGCPClient.EXPECT().DeleteWorkloadIdentityPool("fake-id", "fake-pool").Return(fakeError)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+				result, err := EnsureDeletionProcessed(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(result.RequeueDelay).To(Equal(10 * time.Minute))
+			})
+		})
+
+		Context("When safe mode is enabled", func() {
+			BeforeEach(func() {
+				SetDeletionSafeMode(true)
+				deletionTime := metav1.Now()
+				projectReference.SetDeletionTimestamp(&deletionTime)
+				projectReference.Spec.GCPProjectID = "fake-id"
+			})
+			AfterEach(func() {
+				SetDeletionSafeMode(false)
+			})
+
+			Context("When the project still holds resources", func() {
+				BeforeEach(func() {
+					mockGCPClient.EXPECT().ListServiceAccounts("fake-id").Return([]*iam.ServiceAccount{{Email: "leftover@fake-id.iam.gserviceaccount.com"}}, nil)
+					mockGCPClient.EXPECT().ListRunningInstances("fake-id").Return([]string{"fake-instance"}, nil)
+					mockConditions.EXPECT().SetCondition(gomock.Any(), gcpv1alpha1.ConditionProjectNotEmpty, corev1.ConditionTrue, "ProjectNotEmpty", gomock.Any()).Times(1)
+					mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+					mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				})
+				It("blocks finalization with a ProjectNotEmpty condition", func() {
+					result, err := EnsureDeletionProcessed(adapter)
+					Expect(errors.Is(err, operrors.ErrProjectNotEmpty)).To(BeTrue())
+					Expect(result.RequeueRequest).To(BeTrue())
+					Expect(result.RequeueDelay).To(Equal(5 * time.Minute))
+				})
+			})
+
+			Context("When only default service accounts remain", func() {
+				BeforeEach(func() {
+					mockGCPClient.EXPECT().ListServiceAccounts("fake-id").Return([]*iam.ServiceAccount{
+						{Email: "osd-managed-admin@fake-id.iam.gserviceaccount.com"},
+						{Email: "123456-compute@developer.gserviceaccount.com"},
+						{Email: "fake-id@appspot.gserviceaccount.com"},
+					}, nil)
+					mockGCPClient.EXPECT().ListRunningInstances("fake-id").Return([]string{}, nil)
+				})
+				It("proceeds with the deletion", func() {
+					mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: "fake-id"}}, nil)
+					mockGCPClient.EXPECT().DeleteProject(gomock.Any()).Times(1)
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+					mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1)
+					result, err := EnsureDeletionProcessed(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(stopProcessingResult))
+				})
+			})
+
+			Context("When the override annotation is set", func() {
+				BeforeEach(func() {
+					projectReference.SetAnnotations(map[string]string{DeletionOverrideAnnotation: "true"})
+				})
+				It("skips the safe-mode check and deletes the project", func() {
+					mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: "fake-id"}}, nil)
+					mockGCPClient.EXPECT().DeleteProject(gomock.Any()).Times(1)
+					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+					mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(1)
+					result, err := EnsureDeletionProcessed(adapter)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(stopProcessingResult))
+				})
+			})
+		})
+	})
+
+	Context("EnsureDanglingReferenceProcessed", func() {
+		Context("When the linked claim exists", func() {
+			It("continues processing", func() {
+				result, err := EnsureDanglingReferenceProcessed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(continueProcessingResult))
+			})
+		})
+
+		Context("When the linked claim no longer exists", func() {
+			JustBeforeEach(func() {
+				projectReference.Spec.GCPProjectID = "fake-id"
+				projectReference.Spec.CCS = true
+				projectReference.SetFinalizers([]string{FinalizerName})
+				claimLink := types.NamespacedName{Name: projectReference.Spec.ProjectClaimCRLink.Name, Namespace: projectReference.Spec.ProjectClaimCRLink.Namespace}
+				mockKubeClient.EXPECT().Get(gomock.Any(), claimLink, gomock.Any()).Return(k8serrs.NewNotFound(schema.GroupResource{}, claimLink.Name))
+				adapter, err = NewReferenceAdapter(projectReference, logf.Log.WithName("Test Logger"), mockKubeClient, mockGCPClient, mockConditions)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cleans up GCP resources, removes the finalizer and deletes the reference", func() {
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any()).Times(1)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(2)
+				result, err := EnsureDanglingReferenceProcessed(adapter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(stopProcessingResult))
+			})
+
+			It("backs off when the cleanup fails", func() {
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
+				mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fakeError)
+				mockKubeClient.EXPECT().Status().Return(mockStatusWriter)
+				mockStatusWriter.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				result, err := EnsureDanglingReferenceProcessed(adapter)
+				Expect(err).To(HaveOccurred())
+				Expect(result.RequeueRequest).To(BeTrue())
+			})
+		})
 	})
 
 	Context("UpdateProjectID", func() {
@@ -722,4 +2970,23 @@ var _ = Describe("ProjectreferenceAdapter", func() {
 		})
 
 	})
+
+	Context("UpdateProjectID with a custom IDGenerator", func() {
+		AfterEach(func() {
+			SetIDGenerator(RandomIDGenerator{})
+		})
+		It("uses the ID produced by the generator", func() {
+			SetIDGenerator(staticIDGenerator{id: "cmdb-sourced-id"})
+			mockKubeClient.EXPECT().Update(gomock.Any(), gomock.Any())
+			err := adapter.UpdateProjectID()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(projectReference.Spec.GCPProjectID).To(Equal("cmdb-sourced-id"))
+		})
+		It("rejects a generated ID that is not a valid GCP project ID", func() {
+			SetIDGenerator(staticIDGenerator{id: "Not/A/Valid/ID"})
+			err := adapter.UpdateProjectID()
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, operrors.ErrInvalidProjectID)).To(BeTrue())
+		})
+	})
 })
@@ -2,17 +2,25 @@ package projectreference
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/go-logr/logr"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	condition "github.com/openshift/gcp-project-operator/pkg/condition"
+	"github.com/openshift/gcp-project-operator/pkg/configmap"
+	"github.com/openshift/gcp-project-operator/pkg/errorbudget"
+	"github.com/openshift/gcp-project-operator/pkg/events"
 	"github.com/openshift/gcp-project-operator/pkg/gcpclient"
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
 	"github.com/openshift/gcp-project-operator/pkg/util"
 	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -40,6 +48,16 @@ const (
 * business logic.  Delete these comments after modifying this file.*
  */
 
+// validateOnly holds the operator-wide validate-only switch for the reference controller.
+var validateOnly bool
+
+// SetValidateOnly toggles validate-only mode for the ProjectReference controller.
+// In validate-only mode claim readiness checks still run and conditions are
+// reported, but nothing is changed in GCP or on the ProjectReference.
+func SetValidateOnly(enabled bool) {
+	validateOnly = enabled
+}
+
 // Add creates a new ProjectReference Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
@@ -48,7 +66,7 @@ func Add(mgr manager.Manager) error {
 
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileProjectReference{client: mgr.GetClient(), scheme: mgr.GetScheme(), gcpClientBuilder: gcpclient.NewClient}
+	return &ReconcileProjectReference{client: mgr.GetClient(), scheme: mgr.GetScheme(), gcpClientBuilder: gcpclient.NewClient, recorder: events.NewThrottledRecorder(mgr.GetRecorder("projectreference-controller")), reconcileEnabled: configmap.ReconcileEnabled}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -78,6 +96,9 @@ type ReconcileProjectReference struct {
 	client           client.Client
 	scheme           *runtime.Scheme
 	gcpClientBuilder func(projectName string, authJSON []byte) (gcpclient.Client, error)
+	recorder         record.EventRecorder
+	// reconcileEnabled checks the operator kill-switch; a nil check counts as enabled
+	reconcileEnabled func(kubeClient client.Client) bool
 }
 
 // Reconcile wraps ReconcileHandler() and updates the conditions if any error occurs
@@ -96,6 +117,17 @@ func (r *ReconcileProjectReference) Reconcile(request reconcile.Request) (reconc
 		return r.requeueOnErr(err)
 	}
 
+	// Deletions are still processed while the kill-switch is active
+	if projectReference.DeletionTimestamp == nil && r.reconcileEnabled != nil && !r.reconcileEnabled(r.client) {
+		localmetrics.ReconcileEnabled.Set(0)
+		reqLogger.Info("Reconciliation is paused via the reconcile.enabled kill-switch")
+		if r.recorder != nil {
+			r.recorder.Event(projectReference, corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused via the reconcile.enabled key of the operator ConfigMap")
+		}
+		return r.requeueAfter(5*time.Minute, nil)
+	}
+	localmetrics.ReconcileEnabled.Set(1)
+
 	gcpClient, err := r.getGcpClient(projectReference, reqLogger)
 	if err != nil {
 		return r.requeueOnErr(err)
@@ -107,15 +139,83 @@ func (r *ReconcileProjectReference) Reconcile(request reconcile.Request) (reconc
 		err = operrors.Wrap(err, "could not create ReferenceAdapter")
 		return r.requeueOnErr(err)
 	}
+	adapter.SetEventRecorder(r.recorder)
 
-	result, err := r.ReconcileHandler(adapter, reqLogger)
+	result, err := r.reconcileWithTimeout(adapter, reqLogger)
+	if message, denied := classifyPermissionDenied(err); denied {
+		adapter.reportInsufficientPermissions(message)
+	} else if err == nil {
+		adapter.resolveInsufficientPermissions()
+	}
 	reason := "ReconcileError"
+	if goerrors.Is(err, operrors.ErrProjectNotEmpty) {
+		reason = "ProjectNotEmpty"
+		if r.recorder != nil {
+			r.recorder.Event(projectReference, corev1.EventTypeWarning, "ProjectNotEmpty", "Safe mode blocked project deletion because the project still holds resources")
+		}
+	}
+	adapter.SetSubsystemErrorConditions(err)
 	_ = adapter.SetProjectReferenceCondition(reason, err)
 
+	// Reference reconcile errors are almost exclusively GCP API failures;
+	// count them against the error budget and back off while it is exhausted
+	// so mass operations do not hammer a struggling or quota-limited backend.
+	if err != nil {
+		errorbudget.RecordError()
+	} else if errorbudget.Exhausted() && result.RequeueAfter < errorbudget.BackpressureDelay {
+		reqLogger.Info("GCP error budget exhausted, slowing down reconciles")
+		result.Requeue = true
+		result.RequeueAfter = errorbudget.BackpressureDelay
+	}
+
 	reqLogger.V(1).Info(fmt.Sprintf("Finished Reconcile. Error occured: %t, Requeing: %t, Delay: %d", err != nil, result.Requeue, result.RequeueAfter))
 	return result, err
 }
 
+// defaultReconcileTimeout bounds a single reconcile pass across all its GCP calls.
+const defaultReconcileTimeout = 2 * time.Minute
+
+// reconcileTimeout is how long a single reconcile pass may run before its
+// worker is released and the reference is requeued. Configured via
+// --reconcile-timeout.
+var reconcileTimeout = defaultReconcileTimeout
+
+// SetReconcileTimeout overrides the reconcile deadline. A zero or negative
+// timeout restores the default.
+func SetReconcileTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		reconcileTimeout = defaultReconcileTimeout
+		return
+	}
+	reconcileTimeout = timeout
+}
+
+// reconcileWithTimeout runs ReconcileHandler under the reconcile deadline, so
+// a reconcile stuck on slow GCP calls releases its worker and is retried
+// instead of holding the queue. The in-flight operation keeps running until
+// its current call returns; the deadline only stops waiting for it.
+func (r *ReconcileProjectReference) reconcileWithTimeout(adapter *ReferenceAdapter, reqLogger logr.Logger) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), reconcileTimeout)
+	defer cancel()
+
+	type handlerResult struct {
+		result reconcile.Result
+		err    error
+	}
+	done := make(chan handlerResult, 1)
+	go func() {
+		result, err := r.ReconcileHandler(adapter, reqLogger)
+		done <- handlerResult{result: result, err: err}
+	}()
+
+	select {
+	case handled := <-done:
+		return handled.result, handled.err
+	case <-ctx.Done():
+		return r.requeueOnErr(operrors.Wrap(operrors.ErrReconcileTimeout, fmt.Sprintf("reconcile did not finish within %s", reconcileTimeout)))
+	}
+}
+
 type ReconcileOperation func(*ReferenceAdapter) (util.OperationResult, error)
 
 // ReconcileHandler reads that state of the cluster for a ProjectReference object and makes changes based on the state read
@@ -126,34 +226,110 @@ type ReconcileOperation func(*ReferenceAdapter) (util.OperationResult, error)
 func (r *ReconcileProjectReference) ReconcileHandler(adapter *ReferenceAdapter, reqLogger logr.Logger) (reconcile.Result, error) {
 	operations := []ReconcileOperation{
 		EnsureProjectReferenceInitialized, //Set conditions
+		EnsureTeardownDescribed,           // Keep the teardown annotation current
+		EnsureDanglingReferenceProcessed,  // Self-terminate if the linked claim is gone
 		EnsureDeletionProcessed,           // Cleanup
+		EnsureBillingLinked,               // Relink billing if the requested billing account changed
+		EnsureIAMPolicyHealthy,            // Report IAM drift on the ready path, before the Ready short-circuit below
+		EnsureServiceAccountHealthy,       // Recreate a service account deleted out-of-band, also on the ready path
+		EnsureProjectParent,               // Move a Ready project when the requested parent changed
+		EnsureProjectDisplayName,          // Sync display name edits onto a Ready project
+		EnsureProjectLabels,               // Sync label edits onto a Ready project
+		EnsureRoleBindings,                // Keep spec role bindings applied to a Ready project
 		EnsureProjectClaimReady,           // Make projectReference  be processed based on state of ProjectClaim and Project Reference
 		VerifyProjectClaimPending,         //only make changes to ProjectReference if ProjectClaim is pending
 		EnsureProjectReferenceStatusCreating,
 		EnsureProjectID,
 		EnsureFinalizerAdded,
 		EnsureProjectCreated,
+		EnsureProjectNumberPropagated,
+		EnsureProjectTagsBound,
+		EnsureEssentialContacts,
+		EnsureResourceLocationRestricted,
+		EnsureTrustedImageProjects,
+		EnsureOSLoginRequired,
+		EnsureSerialPortAccessDisabled,
 		EnsureProjectConfigured,
+		EnsureServiceAccountEmailSurfaced, // Requires the service account created above
+		EnsureComputeDefaultMetadata,      // Requires the Compute API enabled above
+		EnsureKMSConfigured,
+		EnsureDefaultNetworkSkipped,
+		EnsureDefaultNetworkDeleted,
+		EnsureBaselineFirewallRules,
+		EnsureOperatorImpersonation,
+		EnsureNotificationChannels,
+		EnsureWorkloadIdentityConfigured,
+		EnsureServicePerimeterJoined,
 		EnsureStateReady,
 	}
+	if validateOnly {
+		// Validate-only mode reports validation results without touching GCP
+		// or modifying the ProjectReference
+		operations = []ReconcileOperation{
+			EnsureProjectReferenceInitialized,
+			VerifyProjectClaimPending,
+		}
+	}
 	for _, operation := range operations {
 		result, err := operation(adapter)
 		if err != nil || result.RequeueRequest {
 			return r.requeueAfter(result.RequeueDelay, err)
 		}
 		if result.CancelRequest {
-			return r.doNotRequeue()
+			// Cancelling only ends this pass; a Ready reference still gets
+			// its interval requeue below so the ready-path checks keep running
+			break
 		}
 	}
+	if adapter.ProjectReference.Status.State == gcpv1alpha1.ProjectReferenceStatusReady {
+		// Ready references re-reconcile on an interval so the ready-path
+		// checks (such as IAM drift detection) keep running. The jitter
+		// spreads them out instead of thundering-herd on the same tick.
+		return r.requeueAfter(JitteredReadyRequeue(), nil)
+	}
 	return r.doNotRequeue()
 }
 
+// readyRequeueInterval is how often Ready references re-reconcile.
+var readyRequeueInterval = 30 * time.Minute
+
+// readyRequeueJitterFraction spreads the ready requeues across
+// interval ± interval*fraction. Configured via --ready-requeue-jitter.
+var readyRequeueJitterFraction = 0.1
+
+// SetReadyRequeue configures the ready-state requeue interval and jitter
+// fraction. Non-positive intervals and fractions outside [0, 1) are ignored.
+func SetReadyRequeue(interval time.Duration, jitterFraction float64) {
+	if interval > 0 {
+		readyRequeueInterval = interval
+	}
+	if jitterFraction >= 0 && jitterFraction < 1 {
+		readyRequeueJitterFraction = jitterFraction
+	}
+}
+
+// JitteredReadyRequeue returns the ready requeue interval with per-call
+// random jitter applied.
+func JitteredReadyRequeue() time.Duration {
+	if readyRequeueJitterFraction <= 0 {
+		return readyRequeueInterval
+	}
+	maxOffset := float64(readyRequeueInterval) * readyRequeueJitterFraction
+	offset := (rand.Float64()*2 - 1) * maxOffset
+	return readyRequeueInterval + time.Duration(offset)
+}
+
 func (r *ReconcileProjectReference) getGcpClient(projectReference *gcpv1alpha1.ProjectReference, logger logr.Logger) (gcpclient.Client, error) {
 	credSecretNamespace := operatorNamespace
 	credSecretName := orgGcpSecretName
 	if projectReference.Spec.CCS {
 		credSecretNamespace = projectReference.Spec.CCSSecretRef.Namespace
 		credSecretName = projectReference.Spec.CCSSecretRef.Name
+	} else if projectReference.Spec.CredentialSource != nil {
+		// Multi-operator setups provision different claims with different
+		// operator credentials; the claim selects its source via the spec.
+		credSecretNamespace = projectReference.Spec.CredentialSource.Namespace
+		credSecretName = projectReference.Spec.CredentialSource.Name
 	}
 	// Get org creds from secret
 	creds, err := util.GetGCPCredentialsFromSecret(r.client, credSecretNamespace, credSecretName)
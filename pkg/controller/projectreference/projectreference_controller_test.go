@@ -2,12 +2,14 @@ package projectreference
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iam/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrs "k8s.io/apimachinery/pkg/api/errors"
@@ -15,12 +17,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	api "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
 	"github.com/openshift/gcp-project-operator/pkg/gcpclient"
+	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
 	"github.com/openshift/gcp-project-operator/pkg/util/mocks"
 	mockGCP "github.com/openshift/gcp-project-operator/pkg/util/mocks/gcpclient"
 	testStructs "github.com/openshift/gcp-project-operator/pkg/util/mocks/structs"
@@ -68,6 +72,8 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 			mockKubeClient,
 			scheme.Scheme,
 			gcpBuilder,
+			nil,
+			nil,
 		}
 		configMap = corev1.ConfigMap{
 			Data: map[string]string{
@@ -113,6 +119,17 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 		})
 	})
 
+	Context("When the reconcile kill-switch is active", func() {
+		It("Requeues after a delay without reconciling", func() {
+			reconciler.reconcileEnabled = func(kubeClient client.Client) bool { return false }
+			mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1)
+			res, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Requeue).To(Equal(true))
+			Expect(res.RequeueAfter).To(Equal(5 * time.Minute))
+		})
+	})
+
 	Context("When reference adapter cannot be created", func() {
 		It("Requeues with error", func() {
 			gomock.InOrder(
@@ -127,10 +144,43 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 		})
 	})
 
+	Context("When the operator runs in validate-only mode", func() {
+		BeforeEach(func() {
+			SetValidateOnly(true)
+			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			gomock.InOrder(
+				mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+					Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata"), "key.json": []byte("fakedata")},
+				}).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *projectClaim).Times(1),
+			)
+		})
+		AfterEach(func() {
+			SetValidateOnly(false)
+		})
+
+		It("Runs checks without touching GCP or the ProjectReference", func() {
+			res, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Requeue).To(Equal(false))
+		})
+	})
+
 	Context("Project Reference State", func() {
 		JustBeforeEach(func() {
 			projectReference.Spec.GCPProjectID = "Project-ID-already-set"
 			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			// The ready-path drift check runs for Ready references; a healthy
+			// policy keeps it from writing conditions in these specs
+			healthyPolicy := &cloudresourcemanager.Policy{}
+			managedMember := fmt.Sprintf("serviceAccount:osd-managed-admin@%s.iam.gserviceaccount.com", projectReference.Spec.GCPProjectID)
+			for _, role := range OSDRequiredRoles {
+				healthyPolicy.Bindings = append(healthyPolicy.Bindings, &cloudresourcemanager.Binding{Role: role, Members: []string{managedMember}})
+			}
+			mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(healthyPolicy, nil).AnyTimes()
+			mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil).AnyTimes()
+			mockGCPClient.EXPECT().UpdateProjectDisplayName(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			gomock.InOrder(
 				mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1),
 				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
@@ -262,6 +312,27 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 
 	})
 
+	Context("When the spec selects a credential source", func() {
+		BeforeEach(func() {
+			projectReference.Spec.CredentialSource = &gcpv1alpha1.NamespacedName{Name: "team-creds", Namespace: "team-namespace"}
+			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1)
+			mockKubeClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Name: "team-creds", Namespace: "team-namespace"}, gomock.Any()).SetArg(2, corev1.Secret{
+				Data: map[string][]byte{"osServiceAccount.json": []byte("team-creds-data"), "key.json": []byte("team-creds-data")},
+			}).Times(1)
+		})
+		It("builds the GCP client from the referenced credentials", func() {
+			var received []byte
+			reconciler.gcpClientBuilder = func(projectName string, authJSON []byte) (gcpclient.Client, error) {
+				received = authJSON
+				return mockGCPClient, fakeError
+			}
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).To(HaveOccurred())
+			Expect(string(received)).To(Equal("team-creds-data"))
+		})
+	})
+
 	Context("When project claim CR is not PendingProject", func() {
 		BeforeEach(func() {
 			projectClaim.Status.State = v1alpha1.ClaimStatusPending
@@ -279,6 +350,117 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 		})
 	})
 
+	Context("When a reconcile exceeds the configured timeout", func() {
+		var released chan struct{}
+
+		BeforeEach(func() {
+			SetReconcileTimeout(50 * time.Millisecond)
+			released = make(chan struct{})
+			projectReference.Spec.GCPProjectID = "Some fake id"
+			projectReference.Status.State = api.ProjectReferenceStatusCreating
+			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			projectReference.SetFinalizers([]string{FinalizerName})
+			gomock.InOrder(
+				mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+					Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata"), "key.json": []byte("fakedata")},
+				}).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *projectClaim).Times(1),
+			)
+			mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap).Times(1)
+			mockGCPClient.EXPECT().ListProjects().DoAndReturn(func() ([]*cloudresourcemanager.Project, error) {
+				defer close(released)
+				time.Sleep(300 * time.Millisecond)
+				return nil, fakeError
+			})
+			mockKubeClient.EXPECT().Status().Return(mockUpdater).AnyTimes()
+			mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes()
+		})
+
+		AfterEach(func() {
+			SetReconcileTimeout(0)
+			// The in-flight operation keeps running after the timeout; wait for
+			// it before the mock controller is finished.
+			Eventually(released).Should(BeClosed())
+		})
+
+		It("requeues with a timeout error instead of blocking", func() {
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, operrors.ErrReconcileTimeout)).To(BeTrue())
+		})
+	})
+
+	Context("When a reconcile fails with a GCP permission-denied error", func() {
+		var (
+			refMatcher   *testStructs.ProjectReferenceMatcher
+			claimMatcher *testStructs.ProjectClaimMatcher
+		)
+
+		BeforeEach(func() {
+			refMatcher = testStructs.NewProjectReferenceMatcher()
+			claimMatcher = testStructs.NewProjectClaimMatcher()
+			projectReference.Spec.GCPProjectID = "Some fake id"
+			projectReference.Status.State = api.ProjectReferenceStatusCreating
+			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			projectReference.SetFinalizers([]string{FinalizerName})
+			gomock.InOrder(
+				mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+					Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata"), "key.json": []byte("fakedata")},
+				}).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *projectClaim).Times(1),
+			)
+			mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap).Times(1)
+			mockGCPClient.EXPECT().ListProjects().Return(nil, &googleapi.Error{
+				Code:    403,
+				Message: "Permission 'resourcemanager.projects.list' denied on resource 'folders/fakefolder'",
+			})
+			mockKubeClient.EXPECT().Status().Return(mockUpdater).AnyTimes()
+			mockUpdater.EXPECT().Update(gomock.Any(), refMatcher).AnyTimes()
+			mockUpdater.EXPECT().Update(gomock.Any(), claimMatcher).AnyTimes()
+		})
+
+		It("raises InsufficientGCPPermissions naming the missing permission on the reference and claim", func() {
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).To(HaveOccurred())
+
+			refCondition := findConditionOrFail(refMatcher.ActualProjectReference.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions)
+			Expect(refCondition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(refCondition.Reason).To(Equal("PermissionDenied"))
+			Expect(refCondition.Message).To(ContainSubstring("resourcemanager.projects.list"))
+
+			claimCondition := findConditionOrFail(claimMatcher.ActualProjectClaim.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions)
+			Expect(claimCondition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(claimCondition.Message).To(ContainSubstring("resourcemanager.projects.list"))
+		})
+	})
+
+	Context("classifyPermissionDenied", func() {
+		It("classifies a 403 naming the missing permission", func() {
+			err := operrors.Wrap(&googleapi.Error{Code: 403, Message: "Permission 'iam.serviceAccounts.create' denied on resource"}, "could not create service account")
+			message, denied := classifyPermissionDenied(err)
+			Expect(denied).To(BeTrue())
+			Expect(message).To(ContainSubstring("iam.serviceAccounts.create"))
+		})
+
+		It("classifies a 403 without a permission name generically", func() {
+			message, denied := classifyPermissionDenied(&googleapi.Error{Code: 403, Message: "The caller does not have permission"})
+			Expect(denied).To(BeTrue())
+			Expect(message).To(ContainSubstring("required permission"))
+		})
+
+		It("does not classify other GCP errors", func() {
+			_, denied := classifyPermissionDenied(&googleapi.Error{Code: 404, Message: "not found"})
+			Expect(denied).To(BeFalse())
+		})
+
+		It("does not classify non-GCP errors", func() {
+			_, denied := classifyPermissionDenied(fakeError)
+			Expect(denied).To(BeFalse())
+		})
+	})
+
 	Context("When processing Project", func() {
 		Context("When it is a CCS project", func() {
 			JustBeforeEach(func() {
@@ -298,15 +480,17 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 
 			Context("When the failing to update Status to Ready", func() {
 				It("It requeues with error", func() {
+					mockGCPClient.EXPECT().GetProject(gomock.Any()).Return(&cloudresourcemanager.Project{}, nil)
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceState(gomock.Any(), gomock.Any()).Return("ENABLED", nil).AnyTimes()
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil).Times(2)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().CreateServiceAccountKey(gomock.Any()).Return(&iam.ServiceAccountKey{PrivateKeyData: "dGVzdAo="}, nil)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError).Times(1)
 					mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(2)
-					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fakeError).Times(2)
+					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(3)
+					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fakeError).Times(3)
 					_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
 					Expect(err).To(HaveOccurred())
 				})
@@ -314,15 +498,18 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 
 			Context("When processes the project reference correctly", func() {
 				It("It does not requeue", func() {
+					mockGCPClient.EXPECT().GetProject(gomock.Any()).Return(&cloudresourcemanager.Project{}, nil)
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceState(gomock.Any(), gomock.Any()).Return("ENABLED", nil).AnyTimes()
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil).Times(2)
+					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(nil, fakeError)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().CreateServiceAccountKey(gomock.Any()).Return(&iam.ServiceAccountKey{PrivateKeyData: "dGVzdAo="}, nil)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError).Times(1)
 					mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-					mockKubeClient.EXPECT().Status().Return(mockUpdater)
-					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(6)
+					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(6)
 					_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -361,9 +548,11 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 				It("It requeues with error", func() {
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap).Times(1)
 					mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: projectReference.Spec.GCPProjectID}}, nil)
+					mockGCPClient.EXPECT().GetProject(gomock.Any()).Return(&cloudresourcemanager.Project{}, nil)
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any())
 					mockGCPClient.EXPECT().EnableAPI(gomock.Any(), gomock.Any()).AnyTimes()
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceState(gomock.Any(), gomock.Any()).Return("ENABLED", nil).AnyTimes()
 					mockGCPClient.EXPECT().CreateCloudBillingAccount(gomock.Any(), gomock.Any()).Return(nil)
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil).Times(2)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
@@ -371,8 +560,8 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 					mockGCPClient.EXPECT().CreateServiceAccountKey(gomock.Any()).Return(&iam.ServiceAccountKey{PrivateKeyData: "dGVzdAo="}, nil)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError).Times(1)
 					mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(2)
-					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fakeError).Times(2)
+					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(3)
+					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(fakeError).Times(3)
 					_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
 					Expect(err).To(HaveOccurred())
 				})
@@ -382,18 +571,21 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 				It("It does not requeue", func() {
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, configMap).Times(1)
 					mockGCPClient.EXPECT().ListProjects().Return([]*cloudresourcemanager.Project{{LifecycleState: "ACTIVE", ProjectId: projectReference.Spec.GCPProjectID}}, nil)
+					mockGCPClient.EXPECT().GetProject(gomock.Any()).Return(&cloudresourcemanager.Project{ProjectNumber: 123456}, nil)
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any())
 					mockGCPClient.EXPECT().EnableAPI(gomock.Any(), gomock.Any()).AnyTimes()
 					mockGCPClient.EXPECT().CreateCloudBillingAccount(gomock.Any(), gomock.Any()).Return(nil)
 					mockGCPClient.EXPECT().ListAPIs(gomock.Any()).Return(OSDRequiredAPIS, nil)
+					mockGCPClient.EXPECT().GetServiceState(gomock.Any(), gomock.Any()).Return("ENABLED", nil).AnyTimes()
 					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil).Times(2)
+					mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(nil, fakeError)
 					mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
 					mockGCPClient.EXPECT().CreateServiceAccountKey(gomock.Any()).Return(&iam.ServiceAccountKey{PrivateKeyData: "dGVzdAo="}, nil)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError).Times(1)
 					mockKubeClient.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
-					mockKubeClient.EXPECT().Status().Return(mockUpdater)
-					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(8)
+					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(8)
 					_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -401,6 +593,128 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 		})
 
 	})
+	Context("When the ProjectReference is Ready", func() {
+		var (
+			refMatcher    *testStructs.ProjectReferenceMatcher
+			claimMatcher  *testStructs.ProjectClaimMatcher
+			healthyPolicy *cloudresourcemanager.Policy
+		)
+
+		BeforeEach(func() {
+			projectReference.Spec.CCS = true
+		})
+
+		JustBeforeEach(func() {
+			refMatcher = testStructs.NewProjectReferenceMatcher()
+			claimMatcher = testStructs.NewProjectClaimMatcher()
+			projectReference.Spec.GCPProjectID = "fake-gcp-project"
+			projectReference.Status.State = api.ProjectReferenceStatusReady
+			healthyPolicy = &cloudresourcemanager.Policy{}
+			for _, role := range OSDRequiredRoles {
+				healthyPolicy.Bindings = append(healthyPolicy.Bindings, &cloudresourcemanager.Binding{
+					Role:    role,
+					Members: []string{"serviceAccount:osd-managed-admin@fake-gcp-project.iam.gserviceaccount.com"},
+				})
+			}
+			projectReference.Status.Conditions = []gcpv1alpha1.Condition{}
+			projectReference.SetFinalizers([]string{FinalizerName})
+			readyClaim := testStructs.NewProjectClaimBuilder().GetProjectClaim()
+			readyClaim.Status.State = gcpv1alpha1.ClaimStatusReady
+			gomock.InOrder(
+				mockKubeClient.EXPECT().Get(gomock.Any(), projectReferenceName, gomock.Any()).SetArg(2, *projectReference).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{
+					Data: map[string][]byte{"osServiceAccount.json": []byte("fakedata"), "key.json": []byte("fakedata")},
+				}).Times(1),
+				mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, *readyClaim).Times(1),
+			)
+			mockKubeClient.EXPECT().Status().Return(mockUpdater).AnyTimes()
+			mockUpdater.EXPECT().Update(gomock.Any(), refMatcher).AnyTimes()
+			mockUpdater.EXPECT().Update(gomock.Any(), claimMatcher).AnyTimes()
+		})
+
+		It("still runs the IAM drift check and reports drift", func() {
+			mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{}, nil)
+			mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil)
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).ToNot(HaveOccurred())
+
+			driftCondition := findConditionOrFail(refMatcher.ActualProjectReference.Status.Conditions, gcpv1alpha1.ConditionIAMDrift)
+			Expect(driftCondition.Status).To(Equal(corev1.ConditionTrue))
+		})
+
+		It("requeues after the jittered ready interval", func() {
+			mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(healthyPolicy, nil)
+			mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil)
+			res, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+		})
+
+		Context("and the requested parent changed", func() {
+			BeforeEach(func() {
+				projectReference.Spec.CCS = false
+				projectReference.Spec.Parent = &gcpv1alpha1.ProjectParent{Type: gcpv1alpha1.ParentTypeFolder, ID: "1234567890"}
+			})
+
+			It("still moves the project and requeues to verify completion", func() {
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(healthyPolicy, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil)
+				mockGCPClient.EXPECT().GetProject("fake-gcp-project").Return(&cloudresourcemanager.Project{
+					Parent: &cloudresourcemanager.ResourceId{Type: "folder", Id: "9999999999"},
+				}, nil)
+				mockGCPClient.EXPECT().MoveProject("fake-gcp-project", "folder", "1234567890").Return(nil)
+				res, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueAfter).To(Equal(30 * time.Second))
+
+				movingCondition := findConditionOrFail(refMatcher.ActualProjectReference.Status.Conditions, gcpv1alpha1.ConditionProjectMoving)
+				Expect(movingCondition.Status).To(Equal(corev1.ConditionTrue))
+			})
+		})
+
+		Context("and a managed role gained an out-of-band member", func() {
+			BeforeEach(func() {
+				projectReference.Spec.IAMPolicyMode = gcpv1alpha1.IAMPolicyModeAuthoritative
+				projectReference.Spec.RoleBindings = []gcpv1alpha1.RoleBinding{
+					{Role: "roles/viewer", Members: []string{"user:listed@example.com"}},
+				}
+			})
+
+			It("still removes the member from the Ready project", func() {
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(healthyPolicy, nil)
+				mockGCPClient.EXPECT().GetServiceAccount(gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil)
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{
+						{Role: "roles/viewer", Members: []string{"user:listed@example.com", "user:intruder@example.com"}},
+					},
+				}, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).DoAndReturn(func(request *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error) {
+					Expect(request.Policy.Bindings).To(HaveLen(1))
+					Expect(request.Policy.Bindings[0].Members).To(Equal([]string{"user:listed@example.com"}))
+					return request.Policy, nil
+				})
+				_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("and the service account was deleted out-of-band", func() {
+			BeforeEach(func() {
+				projectReference.Spec.SkipCredentialsSecret = true
+			})
+
+			It("recreates the service account on the ready path", func() {
+				notFoundError := errors.New("googleapi: Error 404: Service account does not exist")
+				mockGCPClient.EXPECT().GetIamPolicy(gomock.Any()).Return(healthyPolicy, nil).Times(2)
+				mockGCPClient.EXPECT().GetServiceAccount("osd-managed-admin").Return(nil, notFoundError).Times(2)
+				mockGCPClient.EXPECT().CreateServiceAccount(gomock.Any(), gomock.Any()).Return(&iam.ServiceAccount{Email: "Some Email"}, nil)
+				mockGCPClient.EXPECT().SetIamPolicy(gomock.Any()).Return(nil, nil)
+				_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: projectReferenceName})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
 	Context("Given there is a ProjectReference deletion request", func() {
 		var (
 			projects []*cloudresourcemanager.Project
@@ -456,8 +770,8 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 
 			Context("When cleanup fails", func() {
 				It("Gets requeued after 5 seconds", func() {
-					mockKubeClient.EXPECT().Status().Return(mockUpdater)
-					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any())
+					mockKubeClient.EXPECT().Status().Return(mockUpdater).Times(2)
+					mockUpdater.EXPECT().Update(gomock.Any(), gomock.Any()).Times(2)
 					mockGCPClient.EXPECT().DeleteProject(gomock.Any()).Times(1)
 					mockKubeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, corev1.Secret{}).Times(2)
 					mockKubeClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fakeError)
@@ -486,3 +800,14 @@ var _ = Describe("ProjectReference controller reconcilation", func() {
 	})
 
 })
+
+// findConditionOrFail returns the condition of the given type or fails the spec.
+func findConditionOrFail(conditions []gcpv1alpha1.Condition, conditionType gcpv1alpha1.ConditionType) gcpv1alpha1.Condition {
+	for _, cond := range conditions {
+		if cond.Type == conditionType {
+			return cond
+		}
+	}
+	Fail(fmt.Sprintf("condition %s not found", conditionType))
+	return gcpv1alpha1.Condition{}
+}
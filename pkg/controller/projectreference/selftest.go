@@ -0,0 +1,155 @@
+package projectreference
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/googleapi"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gcpv1alpha1 "github.com/openshift/gcp-project-operator/pkg/apis/gcp/v1alpha1"
+	"github.com/openshift/gcp-project-operator/pkg/configmap"
+	"github.com/openshift/gcp-project-operator/pkg/gcpclient"
+	"github.com/openshift/gcp-project-operator/pkg/util"
+	operrors "github.com/openshift/gcp-project-operator/pkg/util/errors"
+)
+
+// corePermissions are the parent-level permissions the operator cannot
+// provision projects without. They are verified once at startup against the
+// configured parent folder.
+var corePermissions = []string{
+	projectCreatePermission,
+	"resourcemanager.projects.get",
+	"resourcemanager.projects.delete",
+}
+
+// Result of the startup permission self-test, served by
+// PermissionSelfTestHandler as a readiness signal.
+var (
+	selfTestMutex   sync.RWMutex
+	selfTestDone    bool
+	selfTestMissing []string
+	selfTestError   error
+)
+
+func recordSelfTestResult(missing []string, err error) {
+	selfTestMutex.Lock()
+	defer selfTestMutex.Unlock()
+	selfTestDone = true
+	selfTestMissing = missing
+	selfTestError = err
+}
+
+// RunPermissionSelfTest verifies at startup that the operator credentials hold
+// the core permissions on the configured parent folder, so broken credentials
+// surface as a readiness failure instead of every claim failing opaquely.
+// Failures to read the operator configuration are reported the same way.
+func RunPermissionSelfTest(kubeClient client.Client) {
+	operatorConfigMap, err := configmap.GetOperatorConfigMap(kubeClient)
+	if err != nil {
+		recordSelfTestResult(nil, operrors.Wrap(err, "could not read the operator ConfigMap for the permission self-test"))
+		return
+	}
+
+	creds, err := util.GetGCPCredentialsFromSecret(kubeClient, operatorNamespace, orgGcpSecretName)
+	if err != nil {
+		recordSelfTestResult(nil, operrors.Wrap(err, "could not read the operator credentials for the permission self-test"))
+		return
+	}
+
+	gcpClient, err := gcpclient.NewClient("", creds)
+	if err != nil {
+		recordSelfTestResult(nil, operrors.Wrap(err, "could not build a GCP client for the permission self-test"))
+		return
+	}
+
+	granted, err := gcpClient.TestParentIamPermissions(string(gcpv1alpha1.ParentTypeFolder), operatorConfigMap.ParentFolderID, corePermissions)
+	if err != nil {
+		recordSelfTestResult(nil, operrors.Wrap(err, "could not test the core permissions on the parent folder"))
+		return
+	}
+
+	missing := []string{}
+	for _, permission := range corePermissions {
+		if held, _ := util.InArray(permission, granted); !held {
+			missing = append(missing, permission)
+		}
+	}
+	if len(missing) > 0 {
+		log.Info(fmt.Sprintf("permission self-test failed, missing core permissions: %s", strings.Join(missing, ", ")))
+	}
+	recordSelfTestResult(missing, nil)
+}
+
+// PermissionSelfTestHandler serves the permission self-test result as a
+// readiness endpoint: 200 once the core permissions were verified, 503 while
+// the self-test is still running, failed, or found permissions missing.
+func PermissionSelfTestHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		selfTestMutex.RLock()
+		defer selfTestMutex.RUnlock()
+		switch {
+		case !selfTestDone:
+			http.Error(w, "permission self-test has not finished yet", http.StatusServiceUnavailable)
+		case selfTestError != nil:
+			http.Error(w, fmt.Sprintf("permission self-test failed: %v", selfTestError), http.StatusServiceUnavailable)
+		case len(selfTestMissing) > 0:
+			http.Error(w, fmt.Sprintf("missing core permissions: %s", strings.Join(selfTestMissing, ", ")), http.StatusServiceUnavailable)
+		default:
+			fmt.Fprintln(w, "ok")
+		}
+	}
+}
+
+// permissionRegexp extracts the permission name from GCP permission-denied
+// messages such as "Permission 'resourcemanager.projects.create' denied on
+// resource 'folders/123'".
+var permissionRegexp = regexp.MustCompile(`(?i)permission '?([a-z][a-zA-Z]*(\.[a-zA-Z]+){2,3})'?`)
+
+// classifyPermissionDenied reports whether err is a GCP permission-denied
+// failure, with a message naming the missing permission when the error
+// identifies one.
+func classifyPermissionDenied(err error) (string, bool) {
+	var apiError *googleapi.Error
+	if !errors.As(err, &apiError) || apiError.Code != http.StatusForbidden {
+		return "", false
+	}
+	if match := permissionRegexp.FindStringSubmatch(apiError.Message); match != nil {
+		return fmt.Sprintf("the operator credentials are missing %s", match[1]), true
+	}
+	return "the operator credentials are missing a required permission", true
+}
+
+// reportInsufficientPermissions raises the InsufficientGCPPermissions
+// condition on the reference and mirrors it to the claim, so the owning team
+// sees why provisioning is stuck. The reference status is persisted by the
+// condition write that follows every reconcile; the claim write is best
+// effort.
+func (r *ReferenceAdapter) reportInsufficientPermissions(message string) {
+	r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions, corev1.ConditionTrue, "PermissionDenied", message)
+	r.conditionManager.SetCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions, corev1.ConditionTrue, "PermissionDenied", message)
+	_ = util.UpdateStatus(r.kubeClient, r.ProjectClaim)
+}
+
+// resolveInsufficientPermissions lowers the condition again after a clean
+// reconcile, on both the reference and its claim.
+func (r *ReferenceAdapter) resolveInsufficientPermissions() {
+	denied := false
+	for _, cond := range r.ProjectReference.Status.Conditions {
+		if cond.Type == gcpv1alpha1.ConditionInsufficientGCPPermissions && cond.Status == corev1.ConditionTrue {
+			denied = true
+			break
+		}
+	}
+	if !denied {
+		return
+	}
+	r.conditionManager.SetCondition(&r.ProjectReference.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions, corev1.ConditionFalse, "PermissionRestored", "")
+	r.conditionManager.SetCondition(&r.ProjectClaim.Status.Conditions, gcpv1alpha1.ConditionInsufficientGCPPermissions, corev1.ConditionFalse, "PermissionRestored", "")
+	_ = util.UpdateStatus(r.kubeClient, r.ProjectClaim)
+}
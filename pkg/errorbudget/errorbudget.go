@@ -0,0 +1,94 @@
+package errorbudget
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openshift/gcp-project-operator/pkg/localmetrics"
+)
+
+// BackpressureDelay is the requeue interval applied while the error budget is
+// exhausted, slowing reconciles down until the error rate recovers.
+const BackpressureDelay = 2 * time.Minute
+
+// window is how long a recorded error counts against the budget.
+var window = 5 * time.Minute
+
+// threshold is the number of errors within the window that exhausts the budget.
+var threshold = 30
+
+var (
+	mutex  sync.Mutex
+	errors []time.Time
+)
+
+// SetWindow overrides how long a recorded error counts against the budget.
+func SetWindow(d time.Duration) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	window = d
+}
+
+// SetThreshold overrides how many errors within the window exhaust the budget.
+func SetThreshold(count int) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	threshold = count
+}
+
+// Reset discards all recorded errors.
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	errors = nil
+	localmetrics.ErrorBudgetRemaining.Set(float64(threshold))
+}
+
+// prune drops errors that have aged out of the window and updates the metric.
+// Callers must hold the mutex.
+func prune() {
+	cutoff := time.Now().Add(-window)
+	kept := errors[:0]
+	for _, occurred := range errors {
+		if occurred.After(cutoff) {
+			kept = append(kept, occurred)
+		}
+	}
+	errors = kept
+
+	remaining := threshold - len(errors)
+	if remaining < 0 {
+		remaining = 0
+	}
+	localmetrics.ErrorBudgetRemaining.Set(float64(remaining))
+}
+
+// RecordError counts a GCP API error against the budget.
+func RecordError() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	errors = append(errors, time.Now())
+	prune()
+}
+
+// Exhausted reports whether the error rate within the window has reached the
+// threshold, meaning reconciles should slow down.
+func Exhausted() bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+	prune()
+	return len(errors) >= threshold
+}
+
+// Remaining returns how many more errors the budget tolerates within the
+// current window.
+func Remaining() int {
+	mutex.Lock()
+	defer mutex.Unlock()
+	prune()
+	remaining := threshold - len(errors)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
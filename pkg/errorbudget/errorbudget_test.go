@@ -0,0 +1,49 @@
+package errorbudget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetExhaustsAtThreshold(t *testing.T) {
+	SetThreshold(3)
+	defer SetThreshold(30)
+	defer Reset()
+
+	RecordError()
+	RecordError()
+	assert.False(t, Exhausted())
+	assert.Equal(t, 1, Remaining())
+
+	RecordError()
+	assert.True(t, Exhausted())
+	assert.Equal(t, 0, Remaining())
+}
+
+func TestBudgetRecoversWhenErrorsAgeOut(t *testing.T) {
+	SetThreshold(1)
+	SetWindow(10 * time.Millisecond)
+	defer SetThreshold(30)
+	defer SetWindow(5 * time.Minute)
+	defer Reset()
+
+	RecordError()
+	assert.True(t, Exhausted())
+
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, Exhausted())
+	assert.Equal(t, 1, Remaining())
+}
+
+func TestResetDiscardsRecordedErrors(t *testing.T) {
+	SetThreshold(1)
+	defer SetThreshold(30)
+
+	RecordError()
+	assert.True(t, Exhausted())
+
+	Reset()
+	assert.False(t, Exhausted())
+}
@@ -0,0 +1,98 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// blank assignment to verify that ThrottledRecorder implements record.EventRecorder
+var _ record.EventRecorder = &ThrottledRecorder{}
+
+// dedupInterval is the minimum time before an identical event is re-emitted.
+var dedupInterval = time.Minute
+
+// SetDedupInterval overrides how often an identical event may be re-emitted.
+func SetDedupInterval(interval time.Duration) {
+	dedupInterval = interval
+}
+
+// occurrence tracks when an event was last emitted and how often it fired since.
+type occurrence struct {
+	lastEmitted time.Time
+	count       int
+}
+
+// ThrottledRecorder wraps an EventRecorder and suppresses identical events
+// (same object, reason and message) fired more often than the dedup interval,
+// so backoff loops do not flood `kubectl describe`. Suppressed occurrences are
+// counted and reported when the event is next emitted.
+type ThrottledRecorder struct {
+	recorder record.EventRecorder
+	mutex    sync.Mutex
+	seen     map[string]*occurrence
+}
+
+// NewThrottledRecorder wraps the given recorder with event deduplication.
+func NewThrottledRecorder(recorder record.EventRecorder) *ThrottledRecorder {
+	return &ThrottledRecorder{
+		recorder: recorder,
+		seen:     map[string]*occurrence{},
+	}
+}
+
+// eventKey identifies an event by the emitting object, reason and message.
+func eventKey(object runtime.Object, reason, message string) string {
+	key := reason + "/" + message
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return key
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName() + "/" + key
+}
+
+// Event emits the event unless an identical one was emitted within the dedup
+// interval. A re-emitted event notes how often it fired in the meantime.
+func (t *ThrottledRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := eventKey(object, reason, message)
+	now := time.Now()
+	seen, ok := t.seen[key]
+	if !ok {
+		t.seen[key] = &occurrence{lastEmitted: now, count: 1}
+		t.recorder.Event(object, eventtype, reason, message)
+		return
+	}
+
+	seen.count++
+	if now.Sub(seen.lastEmitted) < dedupInterval {
+		return
+	}
+
+	t.recorder.Event(object, eventtype, reason, fmt.Sprintf("%s (occurred %d times)", message, seen.count))
+	seen.lastEmitted = now
+	seen.count = 0
+}
+
+// Eventf is like Event, with the message built from a format string.
+func (t *ThrottledRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	t.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// PastEventf passes through without deduplication: backdated events are rare
+// and carry their own timestamp.
+func (t *ThrottledRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
+	t.recorder.PastEventf(object, timestamp, eventtype, reason, messageFmt, args...)
+}
+
+// AnnotatedEventf is like Eventf, dropping the annotations from the dedup key.
+func (t *ThrottledRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	t.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
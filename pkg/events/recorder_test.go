@@ -0,0 +1,72 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func testSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-claim",
+			Namespace: "fake-namespace",
+		},
+	}
+}
+
+func recordedEvents(fake *record.FakeRecorder) []string {
+	events := []string{}
+	for {
+		select {
+		case event := <-fake.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestThrottledRecorderSuppressesRepeatedEvents(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	recorder := NewThrottledRecorder(fake)
+
+	for i := 0; i < 5; i++ {
+		recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused")
+	}
+
+	events := recordedEvents(fake)
+	assert.Equal(t, 1, len(events))
+	assert.Contains(t, events[0], "Reconciliation is paused")
+}
+
+func TestThrottledRecorderKeepsDistinctEvents(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	recorder := NewThrottledRecorder(fake)
+
+	recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused")
+	recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcileError", "something failed")
+
+	assert.Equal(t, 2, len(recordedEvents(fake)))
+}
+
+func TestThrottledRecorderReemitsWithCountAfterInterval(t *testing.T) {
+	SetDedupInterval(10 * time.Millisecond)
+	defer SetDedupInterval(time.Minute)
+
+	fake := record.NewFakeRecorder(10)
+	recorder := NewThrottledRecorder(fake)
+
+	recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused")
+	recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused")
+	time.Sleep(20 * time.Millisecond)
+	recorder.Event(testSecret(), corev1.EventTypeWarning, "ReconcilePaused", "Reconciliation is paused")
+
+	events := recordedEvents(fake)
+	assert.Equal(t, 2, len(events))
+	assert.Contains(t, events[1], "occurred 3 times")
+}
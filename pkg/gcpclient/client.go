@@ -5,13 +5,17 @@ package gcpclient
 //go:generate goimports -local=github.com/openshift/gcp-account-operator -e -w ../util/mocks/$GOPACKAGE/client.go
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	cloudbilling "google.golang.org/api/cloudbilling/v1"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
@@ -35,6 +39,7 @@ type Client interface {
 	DeleteServiceAccount(accountEmail string) error
 	CreateServiceAccountKey(serviceAccountEmail string) (*iam.ServiceAccountKey, error)
 	DeleteServiceAccountKeys(serviceAccountEmail string) error
+	ListServiceAccounts(projectID string) ([]*iam.ServiceAccount, error)
 	// Cloudresourcemanager
 	GetIamPolicy(projectName string) (*cloudresourcemanager.Policy, error)
 	SetIamPolicy(setIamPolicyRequest *cloudresourcemanager.SetIamPolicyRequest) (*cloudresourcemanager.Policy, error)
@@ -42,16 +47,49 @@ type Client interface {
 	CreateProject(parentFolder string) (*cloudresourcemanager.Operation, error)
 	DeleteProject(parentFolder string) (*cloudresourcemanager.Empty, error)
 	GetProject(projectID string) (*cloudresourcemanager.Project, error)
+	UpdateProjectDisplayName(projectID, displayName string) error
+	SetProjectLabels(projectID string, labels map[string]string, removals []string) error
+	MoveProject(projectID, parentType, parentID string) error
+	TestParentIamPermissions(parentType, parentID string, permissions []string) ([]string, error)
+	LookupTagValue(tagKey, tagValue string) (string, error)
+	CreateTagBinding(projectID, tagValueName string) error
+	DeleteTagBinding(projectID, tagValueName string) error
+	SetProjectOrgPolicy(projectID, constraint string, allowedValues []string) error
+	SetProjectBooleanOrgPolicy(projectID, constraint string, enforced bool) error
+	AddProjectToServicePerimeter(perimeterName string, projectNumber int64) error
 
 	// ServiceManagement
 	EnableAPI(projectID, api string) error
 	ListAPIs(projectID string) ([]string, error)
+	GetServiceState(projectID, api string) (string, error)
 
 	// CloudBilling
 	CreateCloudBillingAccount(projectID, billingAccount string) error
+	GetCloudBillingAccount(projectID string) (string, error)
 
 	//Compute
 	ListAvailabilityZones(projectID, region string) ([]string, error)
+	DeleteDefaultNetwork(projectID string) error
+	ListRunningInstances(projectID string) ([]string, error)
+	CreateFirewallRule(projectID string, firewall *compute.Firewall) error
+	SetComputeProjectMetadata(projectID string, metadata map[string]string) error
+
+	// EssentialContacts
+	CreateEssentialContact(projectID, email string, categories []string) error
+
+	// Monitoring
+	CreateNotificationChannel(projectID, channelType, displayName string, labels map[string]string) (string, error)
+
+	// Cloud KMS
+	CreateKMSKeyRing(projectID, location, keyRing string) error
+	CreateKMSCryptoKey(projectID, location, keyRing, key string, rotationPeriod time.Duration) error
+
+	// Workload Identity Federation
+	CreateWorkloadIdentityPool(projectID, poolID string) error
+	CreateWorkloadIdentityProvider(projectID, poolID, providerID, issuerURI string, allowedAudiences []string) error
+	DeleteWorkloadIdentityPool(projectID, poolID string) error
+	BindWorkloadIdentityUser(projectID, serviceAccountEmail, member string) error
+	BindServiceAccountTokenCreator(projectID, serviceAccountEmail, member string) error
 }
 
 type gcpClient struct {
@@ -136,6 +174,116 @@ func (c *gcpClient) ListAvailabilityZones(projectID, region string) ([]string, e
 	return zones, nil
 }
 
+// DeleteDefaultNetwork removes the auto-mode default network from the project,
+// deleting its firewall rules first since a network cannot be deleted while
+// rules still reference it. A project without the default network is left
+// unchanged.
+func (c *gcpClient) DeleteDefaultNetwork(projectID string) error {
+	_, err := c.computeClient.Networks.Get(projectID, "default").Do()
+	if err != nil {
+		if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("gcpclient.DeleteDefaultNetwork.Networks.Get %v", err)
+	}
+
+	firewalls, err := c.computeClient.Firewalls.List(projectID).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.DeleteDefaultNetwork.Firewalls.List %v", err)
+	}
+	for _, firewall := range firewalls.Items {
+		if !strings.HasSuffix(firewall.Network, "/networks/default") {
+			continue
+		}
+		if _, err := c.computeClient.Firewalls.Delete(projectID, firewall.Name).Do(); err != nil {
+			return fmt.Errorf("gcpclient.DeleteDefaultNetwork.Firewalls.Delete %v", err)
+		}
+	}
+
+	if _, err := c.computeClient.Networks.Delete(projectID, "default").Do(); err != nil {
+		return fmt.Errorf("gcpclient.DeleteDefaultNetwork.Networks.Delete %v", err)
+	}
+	return nil
+}
+
+// CreateFirewallRule creates a firewall rule in the project. A rule that
+// already exists is left as is, so repeated reconciles do not fail.
+func (c *gcpClient) CreateFirewallRule(projectID string, firewall *compute.Firewall) error {
+	_, err := c.computeClient.Firewalls.Insert(projectID, firewall).Do()
+	if err != nil {
+		// google uses 409 for "already exists"
+		if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusConflict {
+			return nil
+		}
+		return fmt.Errorf("gcpclient.CreateFirewallRule.Firewalls.Insert %v", err)
+	}
+	return nil
+}
+
+// SetComputeProjectMetadata merges the given key/value pairs into the
+// project's Compute Engine common instance metadata. Other metadata entries
+// are left alone and the update call is skipped when nothing would change.
+func (c *gcpClient) SetComputeProjectMetadata(projectID string, metadata map[string]string) error {
+	project, err := c.computeClient.Projects.Get(projectID).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetComputeProjectMetadata.Projects.Get %v", err)
+	}
+
+	merged := project.CommonInstanceMetadata
+	if merged == nil {
+		merged = &compute.Metadata{}
+	}
+	changed := false
+	for key, val := range metadata {
+		val := val
+		found := false
+		for _, item := range merged.Items {
+			if item.Key == key {
+				found = true
+				if item.Value == nil || *item.Value != val {
+					item.Value = &val
+					changed = true
+				}
+				break
+			}
+		}
+		if !found {
+			merged.Items = append(merged.Items, &compute.MetadataItems{Key: key, Value: &val})
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	_, err = c.computeClient.Projects.SetCommonInstanceMetadata(projectID, merged).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetComputeProjectMetadata.Projects.SetCommonInstanceMetadata %v", err)
+	}
+	return nil
+}
+
+// ListRunningInstances returns the names of the compute instances currently
+// running in the project, across all zones
+func (c *gcpClient) ListRunningInstances(projectID string) ([]string, error) {
+	instances := []string{}
+	req := c.computeClient.Instances.AggregatedList(projectID)
+	err := req.Pages(context.Background(), func(page *compute.InstanceAggregatedList) error {
+		for _, scopedList := range page.Items {
+			for _, instance := range scopedList.Instances {
+				if instance.Status == "RUNNING" {
+					instances = append(instances, instance.Name)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gcpclient.ListRunningInstances.Instances.AggregatedList %v", err)
+	}
+	return instances, nil
+}
+
 // ListProjects returns a list of all projects
 func (c *gcpClient) ListProjects() ([]*cloudresourcemanager.Project, error) {
 	resp, err := c.cloudResourceManagerClient.Projects.List().Do()
@@ -180,6 +328,206 @@ func (c *gcpClient) CreateProject(parentFolderID string) (*cloudresourcemanager.
 	return operation, nil
 }
 
+// UpdateProjectDisplayName sets the display name on an existing project.
+func (c *gcpClient) UpdateProjectDisplayName(projectID, displayName string) error {
+	project, err := c.cloudResourceManagerClient.Projects.Get(projectID).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.UpdateProjectDisplayName.Projects.Get %v", err)
+	}
+	if project.Name == displayName {
+		return nil
+	}
+	project.Name = displayName
+	_, err = c.cloudResourceManagerClient.Projects.Update(projectID, project).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.UpdateProjectDisplayName.Projects.Update %v", err)
+	}
+	return nil
+}
+
+// SetProjectLabels applies the given labels to an existing project and removes
+// the given label keys from it. Labels are compared by content first and the
+// update call is skipped when nothing would change, since GCP returns label
+// maps in arbitrary order.
+func (c *gcpClient) SetProjectLabels(projectID string, labels map[string]string, removals []string) error {
+	project, err := c.cloudResourceManagerClient.Projects.Get(projectID).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetProjectLabels.Projects.Get %v", err)
+	}
+
+	changed := false
+	for key, val := range labels {
+		if project.Labels[key] != val {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		for _, key := range removals {
+			if _, ok := project.Labels[key]; ok {
+				changed = true
+				break
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	if project.Labels == nil {
+		project.Labels = map[string]string{}
+	}
+	for _, key := range removals {
+		delete(project.Labels, key)
+	}
+	for key, val := range labels {
+		project.Labels[key] = val
+	}
+	_, err = c.cloudResourceManagerClient.Projects.Update(projectID, project).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetProjectLabels.Projects.Update %v", err)
+	}
+	return nil
+}
+
+// MoveProject re-parents an existing project under the given folder or organization.
+func (c *gcpClient) MoveProject(projectID, parentType, parentID string) error {
+	project, err := c.cloudResourceManagerClient.Projects.Get(projectID).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.MoveProject.Projects.Get %v", err)
+	}
+	if project.Parent != nil && project.Parent.Type == parentType && project.Parent.Id == parentID {
+		return nil
+	}
+	project.Parent = &cloudresourcemanager.ResourceId{
+		Type: parentType,
+		Id:   parentID,
+	}
+	_, err = c.cloudResourceManagerClient.Projects.Update(projectID, project).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.MoveProject.Projects.Update %v", err)
+	}
+	return nil
+}
+
+// TestParentIamPermissions returns which of the given permissions the operator
+// identity holds on the folder or organization a project would be created
+// under. The vendored v1 client only covers organizations, so folders are
+// called directly via the v2 REST API.
+func (c *gcpClient) TestParentIamPermissions(parentType, parentID string, permissions []string) ([]string, error) {
+	if parentType == "organization" {
+		response, err := c.cloudResourceManagerClient.Organizations.TestIamPermissions(fmt.Sprintf("organizations/%s", parentID), &cloudresourcemanager.TestIamPermissionsRequest{
+			Permissions: permissions,
+		}).Do()
+		if err != nil {
+			return nil, fmt.Errorf("gcpclient.TestParentIamPermissions.Organizations.TestIamPermissions %v", err)
+		}
+		return response.Permissions, nil
+	}
+
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	body, err := json.Marshal(map[string]interface{}{
+		"permissions": permissions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gcpclient.TestParentIamPermissions.Folders.TestIamPermissions %v", err)
+	}
+	folderURL := fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v2/folders/%s:testIamPermissions", parentID)
+	resp, err := httpClient.Post(folderURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("gcpclient.TestParentIamPermissions.Folders.TestIamPermissions %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("gcpclient.TestParentIamPermissions.Folders.TestIamPermissions unexpected status %s", resp.Status)
+	}
+	var response struct {
+		Permissions []string `json:"permissions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("gcpclient.TestParentIamPermissions.Folders.TestIamPermissions %v", err)
+	}
+	return response.Permissions, nil
+}
+
+// tagBindingParent returns the full resource name of a project as expected by
+// the resource manager tag binding API.
+func tagBindingParent(projectID string) string {
+	return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", projectID)
+}
+
+// LookupTagValue resolves a tag key/value pair given by namespaced short names
+// (e.g. "123456789012/env" and "prod") to the tag value resource name
+// (tagValues/<id>). Resource manager tags are only covered by the v3 API,
+// which is not vendored, so it is called directly.
+func (c *gcpClient) LookupTagValue(tagKey, tagValue string) (string, error) {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	lookupURL := fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v3/tagValues/namespaced?name=%s", url.QueryEscape(tagKey+"/"+tagValue))
+	resp, err := httpClient.Get(lookupURL)
+	if err != nil {
+		return "", fmt.Errorf("gcpclient.LookupTagValue.TagValues.GetNamespaced %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("gcpclient.LookupTagValue.TagValues.GetNamespaced unexpected status %s", resp.Status)
+	}
+	var value struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&value); err != nil {
+		return "", fmt.Errorf("gcpclient.LookupTagValue.TagValues.GetNamespaced %v", err)
+	}
+	return value.Name, nil
+}
+
+// CreateTagBinding binds a tag value (tagValues/<id>) to the project. Binding
+// a value that is already bound is not an error.
+func (c *gcpClient) CreateTagBinding(projectID, tagValueName string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	body, err := json.Marshal(map[string]string{
+		"parent":   tagBindingParent(projectID),
+		"tagValue": tagValueName,
+	})
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateTagBinding.TagBindings.Create %v", err)
+	}
+	resp, err := httpClient.Post("https://cloudresourcemanager.googleapis.com/v3/tagBindings", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateTagBinding.TagBindings.Create %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateTagBinding.TagBindings.Create unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// DeleteTagBinding removes the binding of a tag value (tagValues/<id>) from
+// the project. A binding that no longer exists is not an error.
+func (c *gcpClient) DeleteTagBinding(projectID, tagValueName string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	bindingName := fmt.Sprintf("tagBindings/%s/%s", url.PathEscape(tagBindingParent(projectID)), tagValueName)
+	request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v3/%s", bindingName), nil)
+	if err != nil {
+		return fmt.Errorf("gcpclient.DeleteTagBinding.TagBindings.Delete %v", err)
+	}
+	resp, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("gcpclient.DeleteTagBinding.TagBindings.Delete %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.DeleteTagBinding.TagBindings.Delete unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
 // DeleteProject deletes a project from a given folder.
 func (c *gcpClient) DeleteProject(parentFolder string) (*cloudresourcemanager.Empty, error) {
 	empty, err := c.cloudResourceManagerClient.Projects.Delete(c.projectName).Do()
@@ -226,6 +574,15 @@ func (c *gcpClient) DeleteServiceAccount(accountEmail string) error {
 	return nil
 }
 
+// ListServiceAccounts returns the service accounts that exist in the project
+func (c *gcpClient) ListServiceAccounts(projectID string) ([]*iam.ServiceAccount, error) {
+	resp, err := c.iamClient.Projects.ServiceAccounts.List(fmt.Sprintf("projects/%s", projectID)).Do()
+	if err != nil {
+		return nil, fmt.Errorf("gcpclient.ListServiceAccounts.Projects.ServiceAccounts.List: %v", err)
+	}
+	return resp.Accounts, nil
+}
+
 func (c *gcpClient) CreateServiceAccountKey(serviceAccountEmail string) (*iam.ServiceAccountKey, error) {
 	key, err := c.iamClient.Projects.ServiceAccounts.Keys.Create(fmt.Sprintf("projects/%s/serviceAccounts/%s", c.projectName, serviceAccountEmail), &iam.CreateServiceAccountKeyRequest{}).Do()
 	if err != nil {
@@ -234,7 +591,7 @@ func (c *gcpClient) CreateServiceAccountKey(serviceAccountEmail string) (*iam.Se
 	return key, nil
 }
 
-//DeleteServiceAccountKeys deletes all keys associated with the service account
+// DeleteServiceAccountKeys deletes all keys associated with the service account
 func (c *gcpClient) DeleteServiceAccountKeys(serviceAccountEmail string) error {
 	resource := fmt.Sprintf("projects/%s/serviceAccounts/%s", c.projectName, serviceAccountEmail)
 	response, err := c.iamClient.Projects.ServiceAccounts.Keys.List(resource).Do()
@@ -280,6 +637,102 @@ func (c *gcpClient) SetIamPolicy(setIamPolicyRequest *cloudresourcemanager.SetIa
 	return policy, nil
 }
 
+// SetProjectOrgPolicy applies a list constraint org policy scoped to the project,
+// allowing only the given values.
+func (c *gcpClient) SetProjectOrgPolicy(projectID, constraint string, allowedValues []string) error {
+	request := &cloudresourcemanager.SetOrgPolicyRequest{
+		Policy: &cloudresourcemanager.OrgPolicy{
+			Constraint: constraint,
+			ListPolicy: &cloudresourcemanager.ListPolicy{
+				AllowedValues: allowedValues,
+			},
+		},
+	}
+	_, err := c.cloudResourceManagerClient.Projects.SetOrgPolicy(fmt.Sprintf("projects/%s", projectID), request).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetProjectOrgPolicy.Projects.SetOrgPolicy %v", err)
+	}
+
+	return nil
+}
+
+// SetProjectBooleanOrgPolicy applies a boolean constraint org policy scoped to
+// the project, enforcing or lifting it.
+func (c *gcpClient) SetProjectBooleanOrgPolicy(projectID, constraint string, enforced bool) error {
+	request := &cloudresourcemanager.SetOrgPolicyRequest{
+		Policy: &cloudresourcemanager.OrgPolicy{
+			Constraint: constraint,
+			BooleanPolicy: &cloudresourcemanager.BooleanPolicy{
+				Enforced: enforced,
+			},
+		},
+	}
+	_, err := c.cloudResourceManagerClient.Projects.SetOrgPolicy(fmt.Sprintf("projects/%s", projectID), request).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.SetProjectBooleanOrgPolicy.Projects.SetOrgPolicy %v", err)
+	}
+
+	return nil
+}
+
+// AddProjectToServicePerimeter adds the project to the given VPC Service
+// Controls perimeter if it is not a member yet. The Access Context Manager API
+// is not covered by the vendored client libraries, so it is called directly.
+func (c *gcpClient) AddProjectToServicePerimeter(perimeterName string, projectNumber int64) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	perimeterURL := fmt.Sprintf("https://accesscontextmanager.googleapis.com/v1/%s", perimeterName)
+	projectResource := fmt.Sprintf("projects/%d", projectNumber)
+
+	resp, err := httpClient.Get(perimeterURL)
+	if err != nil {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Get %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Get unexpected status %s", resp.Status)
+	}
+
+	var perimeter struct {
+		Status struct {
+			Resources []string `json:"resources"`
+		} `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&perimeter); err != nil {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Get %v", err)
+	}
+	for _, resource := range perimeter.Status.Resources {
+		if resource == projectResource {
+			return nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("adding %s to service perimeter %s", projectResource, perimeterName))
+	body, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"resources": append(perimeter.Status.Resources, projectResource),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Patch %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, perimeterURL+"?updateMask=status.resources", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Patch %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	patchResp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Patch %v", err)
+	}
+	defer patchResp.Body.Close()
+	if patchResp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.AddProjectToServicePerimeter.ServicePerimeters.Patch unexpected status %s", patchResp.Status)
+	}
+
+	return nil
+}
+
 func (c *gcpClient) ListAPIs(projectID string) ([]string, error) {
 	enabledAPIs := []string{}
 	response, err := c.serviceManagmentClient.Services.List().ConsumerId("project:" + projectID).Do()
@@ -292,6 +745,30 @@ func (c *gcpClient) ListAPIs(projectID string) ([]string, error) {
 	return enabledAPIs, err
 }
 
+// GetServiceState returns the enablement state of an API on the project as
+// reported by the Service Usage API, e.g. ENABLED or DISABLED. Enabling a
+// service returns before the change has propagated, so callers poll this
+// until the service actually reports ENABLED.
+func (c *gcpClient) GetServiceState(projectID, api string) (string, error) {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	resp, err := httpClient.Get(fmt.Sprintf("https://serviceusage.googleapis.com/v1/projects/%s/services/%s", projectID, api))
+	if err != nil {
+		return "", fmt.Errorf("gcpclient.GetServiceState.Services.Get %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("gcpclient.GetServiceState.Services.Get unexpected status %s", resp.Status)
+	}
+
+	var service struct {
+		State string `json:"state"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&service); err != nil {
+		return "", fmt.Errorf("gcpclient.GetServiceState.Services.Get %v", err)
+	}
+	return service.State, nil
+}
+
 func (c *gcpClient) EnableAPI(projectID, api string) error {
 	log.V(1).Info(fmt.Sprintf("enable %s api", api))
 	enableServicerequest := &serviceManagment.EnableServiceRequest{
@@ -323,6 +800,355 @@ func (c *gcpClient) EnableAPI(projectID, api string) error {
 	}
 }
 
+// CreateEssentialContact creates an essential contact on the project if it does not exist yet.
+// The Essential Contacts API is not covered by the vendored client libraries, so it is called directly.
+func (c *gcpClient) CreateEssentialContact(projectID, email string, categories []string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	contactsURL := fmt.Sprintf("https://essentialcontacts.googleapis.com/v1/projects/%s/contacts", projectID)
+
+	resp, err := httpClient.Get(contactsURL)
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.List %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.List unexpected status %s", resp.Status)
+	}
+
+	var existing struct {
+		Contacts []struct {
+			Email string `json:"email"`
+		} `json:"contacts"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&existing); err != nil {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.List %v", err)
+	}
+	for _, contact := range existing.Contacts {
+		if contact.Email == email {
+			return nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("creating essential contact %s", email))
+	body, err := json.Marshal(map[string]interface{}{
+		"email":                             email,
+		"notificationCategorySubscriptions": categories,
+		"languageTag":                       "en-US",
+	})
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.Create %v", err)
+	}
+
+	createResp, err := httpClient.Post(contactsURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.Create %v", err)
+	}
+	defer createResp.Body.Close()
+	if createResp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateEssentialContact.Contacts.Create unexpected status %s", createResp.Status)
+	}
+
+	return nil
+}
+
+// CreateNotificationChannel creates a monitoring notification channel in the
+// project if one with the same type and display name does not exist yet, and
+// returns the channel's resource name. The Monitoring API is not covered by
+// the vendored client libraries, so it is called directly.
+func (c *gcpClient) CreateNotificationChannel(projectID, channelType, displayName string, labels map[string]string) (string, error) {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	channelsURL := fmt.Sprintf("https://monitoring.googleapis.com/v3/projects/%s/notificationChannels", projectID)
+
+	resp, err := httpClient.Get(channelsURL)
+	if err != nil {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.List %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.List unexpected status %s", resp.Status)
+	}
+
+	var existing struct {
+		NotificationChannels []struct {
+			Name        string `json:"name"`
+			Type        string `json:"type"`
+			DisplayName string `json:"displayName"`
+		} `json:"notificationChannels"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&existing); err != nil {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.List %v", err)
+	}
+	for _, channel := range existing.NotificationChannels {
+		if channel.Type == channelType && channel.DisplayName == displayName {
+			return channel.Name, nil
+		}
+	}
+
+	log.V(1).Info(fmt.Sprintf("creating notification channel %s", displayName))
+	body, err := json.Marshal(map[string]interface{}{
+		"type":        channelType,
+		"displayName": displayName,
+		"labels":      labels,
+	})
+	if err != nil {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.Create %v", err)
+	}
+
+	createResp, err := httpClient.Post(channelsURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.Create %v", err)
+	}
+	defer createResp.Body.Close()
+	if createResp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.Create unexpected status %s", createResp.Status)
+	}
+
+	var created struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("gcpclient.CreateNotificationChannel.NotificationChannels.Create %v", err)
+	}
+
+	return created.Name, nil
+}
+
+// kmsKeyRingName returns the full resource name of a KMS keyring.
+func kmsKeyRingName(projectID, location, keyRing string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", projectID, location, keyRing)
+}
+
+// CreateKMSKeyRing creates a KMS keyring in the given location of the project.
+// An already existing keyring is not treated as an error. The Cloud KMS API is
+// not covered by the vendored client libraries, so it is called directly.
+func (c *gcpClient) CreateKMSKeyRing(projectID, location, keyRing string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	keyRingsURL := fmt.Sprintf("https://cloudkms.googleapis.com/v1/projects/%s/locations/%s/keyRings?keyRingId=%s", projectID, location, url.QueryEscape(keyRing))
+
+	resp, err := httpClient.Post(keyRingsURL, "application/json", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateKMSKeyRing.KeyRings.Create %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateKMSKeyRing.KeyRings.Create unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// CreateKMSCryptoKey creates a symmetric encryption key in a keyring, with
+// automatic rotation when a rotation period is given. An already existing key
+// is not treated as an error; its rotation schedule is left untouched.
+func (c *gcpClient) CreateKMSCryptoKey(projectID, location, keyRing, key string, rotationPeriod time.Duration) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	keysURL := fmt.Sprintf("https://cloudkms.googleapis.com/v1/%s/cryptoKeys?cryptoKeyId=%s", kmsKeyRingName(projectID, location, keyRing), url.QueryEscape(key))
+
+	cryptoKey := map[string]interface{}{
+		"purpose": "ENCRYPT_DECRYPT",
+	}
+	if rotationPeriod > 0 {
+		cryptoKey["rotationPeriod"] = fmt.Sprintf("%ds", int64(rotationPeriod.Seconds()))
+		cryptoKey["nextRotationTime"] = time.Now().Add(rotationPeriod).UTC().Format(time.RFC3339)
+	}
+	body, err := json.Marshal(cryptoKey)
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateKMSCryptoKey.CryptoKeys.Create %v", err)
+	}
+
+	resp, err := httpClient.Post(keysURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateKMSCryptoKey.CryptoKeys.Create %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateKMSCryptoKey.CryptoKeys.Create unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// workloadIdentityPoolURL returns the REST URL of a workload identity pool.
+// The Workload Identity Federation API is not covered by the vendored client libraries,
+// so it is called directly.
+func workloadIdentityPoolURL(projectID, poolID string) string {
+	return fmt.Sprintf("https://iam.googleapis.com/v1/projects/%s/locations/global/workloadIdentityPools/%s", projectID, poolID)
+}
+
+// CreateWorkloadIdentityPool creates a workload identity pool in the project.
+// An already existing pool is not treated as an error.
+func (c *gcpClient) CreateWorkloadIdentityPool(projectID, poolID string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	poolsURL := fmt.Sprintf("https://iam.googleapis.com/v1/projects/%s/locations/global/workloadIdentityPools?workloadIdentityPoolId=%s", projectID, poolID)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"displayName": poolID,
+	})
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityPool %v", err)
+	}
+
+	resp, err := httpClient.Post(poolsURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityPool %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityPool unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// CreateWorkloadIdentityProvider creates an OIDC provider inside a workload identity pool.
+// An already existing provider is not treated as an error.
+func (c *gcpClient) CreateWorkloadIdentityProvider(projectID, poolID, providerID, issuerURI string, allowedAudiences []string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+	providersURL := fmt.Sprintf("%s/providers?workloadIdentityPoolProviderId=%s", workloadIdentityPoolURL(projectID, poolID), providerID)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"displayName": providerID,
+		"oidc": map[string]interface{}{
+			"issuerUri":        issuerURI,
+			"allowedAudiences": allowedAudiences,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityProvider %v", err)
+	}
+
+	resp, err := httpClient.Post(providersURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityProvider %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusConflict {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.CreateWorkloadIdentityProvider unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// DeleteWorkloadIdentityPool deletes a workload identity pool and the providers inside it.
+// A pool that is already gone is not treated as an error.
+func (c *gcpClient) DeleteWorkloadIdentityPool(projectID, poolID string) error {
+	httpClient := oauth2.NewClient(context.TODO(), c.credentials.TokenSource)
+
+	request, err := http.NewRequest(http.MethodDelete, workloadIdentityPoolURL(projectID, poolID), nil)
+	if err != nil {
+		return fmt.Errorf("gcpclient.DeleteWorkloadIdentityPool %v", err)
+	}
+
+	resp, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("gcpclient.DeleteWorkloadIdentityPool %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gcpclient.DeleteWorkloadIdentityPool unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// BindWorkloadIdentityUser grants roles/iam.workloadIdentityUser on the service account
+// to the given member, so identities from the workload identity pool can impersonate it.
+func (c *gcpClient) BindWorkloadIdentityUser(projectID, serviceAccountEmail, member string) error {
+	const workloadIdentityUserRole = "roles/iam.workloadIdentityUser"
+	resource := fmt.Sprintf("projects/%s/serviceAccounts/%s", projectID, serviceAccountEmail)
+
+	policy, err := c.iamClient.Projects.ServiceAccounts.GetIamPolicy(resource).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.BindWorkloadIdentityUser.ServiceAccounts.GetIamPolicy %v", err)
+	}
+
+	var binding *iam.Binding
+	for _, b := range policy.Bindings {
+		if b.Role == workloadIdentityUserRole {
+			binding = b
+			break
+		}
+	}
+	if binding == nil {
+		binding = &iam.Binding{Role: workloadIdentityUserRole}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+	for _, existing := range binding.Members {
+		if existing == member {
+			return nil
+		}
+	}
+	binding.Members = append(binding.Members, member)
+
+	_, err = c.iamClient.Projects.ServiceAccounts.SetIamPolicy(resource, &iam.SetIamPolicyRequest{Policy: policy}).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.BindWorkloadIdentityUser.ServiceAccounts.SetIamPolicy %v", err)
+	}
+	return nil
+}
+
+// BindServiceAccountTokenCreator grants the member roles/iam.serviceAccountTokenCreator
+// on the service account, allowing it to mint tokens for the account instead of
+// holding long-lived keys. Adding an already present member is a no-op.
+func (c *gcpClient) BindServiceAccountTokenCreator(projectID, serviceAccountEmail, member string) error {
+	const tokenCreatorRole = "roles/iam.serviceAccountTokenCreator"
+	resource := fmt.Sprintf("projects/%s/serviceAccounts/%s", projectID, serviceAccountEmail)
+
+	policy, err := c.iamClient.Projects.ServiceAccounts.GetIamPolicy(resource).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.BindServiceAccountTokenCreator.ServiceAccounts.GetIamPolicy %v", err)
+	}
+
+	var binding *iam.Binding
+	for _, b := range policy.Bindings {
+		if b.Role == tokenCreatorRole {
+			binding = b
+			break
+		}
+	}
+	if binding == nil {
+		binding = &iam.Binding{Role: tokenCreatorRole}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+	for _, existing := range binding.Members {
+		if existing == member {
+			return nil
+		}
+	}
+	binding.Members = append(binding.Members, member)
+
+	_, err = c.iamClient.Projects.ServiceAccounts.SetIamPolicy(resource, &iam.SetIamPolicyRequest{Policy: policy}).Do()
+	if err != nil {
+		return fmt.Errorf("gcpclient.BindServiceAccountTokenCreator.ServiceAccounts.SetIamPolicy %v", err)
+	}
+	return nil
+}
+
+// GetCloudBillingAccount returns the name of the billing account currently linked to the project
+func (c *gcpClient) GetCloudBillingAccount(projectID string) (string, error) {
+	project := fmt.Sprintf("projects/%s", projectID)
+	info, err := c.cloudBillingClient.Projects.GetBillingInfo(project).Do()
+	if err != nil {
+		return "", err
+	}
+	return info.BillingAccountName, nil
+}
+
 // CreateCloudBillingAccount associates cloud billing account with project
 // TODO: This needs unit testing. Sensitive place
 func (c *gcpClient) CreateCloudBillingAccount(projectID, billingAccountID string) error {
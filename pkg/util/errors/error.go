@@ -29,3 +29,72 @@ var ErrInactiveProject = errors.New("InactiveProject")
 
 // ErrUnexpectedLifecycleState indicates that the project is in an unexpacted LifecycleState
 var ErrUnexpectedLifecycleState = errors.New("UnexpectedLifecycleState")
+
+// ErrReferenceNamespaceMissing indicates that the namespace for ProjectReference CRs does not exist
+var ErrReferenceNamespaceMissing = errors.New("ReferenceNamespaceMissing")
+
+// ErrBillingLinkFailed indicates that the billing account could not be linked to the project
+var ErrBillingLinkFailed = errors.New("BillingLinkFailed")
+
+// ErrProjectIDTaken indicates that the generated project ID could not be used to create a project
+var ErrProjectIDTaken = errors.New("ProjectIDTaken")
+
+// ErrInvalidEssentialContact indicates that a requested essential contact has an invalid email address
+var ErrInvalidEssentialContact = errors.New("InvalidEssentialContact")
+
+// ErrInvalidResourceLocation indicates that a requested resource location restriction is not supported
+var ErrInvalidResourceLocation = errors.New("InvalidResourceLocation")
+
+// ErrInvalidCredentialsSecret indicates that the credentials secret contains no usable key material
+var ErrInvalidCredentialsSecret = errors.New("InvalidCredentialsSecret")
+
+// ErrInvalidParent indicates that the requested project parent has an unknown type or a malformed ID
+var ErrInvalidParent = errors.New("InvalidParent")
+
+// ErrInvalidDisplayName indicates that the requested project display name has an invalid length or charset
+var ErrInvalidDisplayName = errors.New("InvalidDisplayName")
+
+// ErrInvalidTrustedImageProject indicates that a requested trusted image project is not of the form projects/<id>
+var ErrInvalidTrustedImageProject = errors.New("InvalidTrustedImageProject")
+
+// ErrInconsistentSpec indicates that the claim spec combines mutually exclusive options
+var ErrInconsistentSpec = errors.New("InconsistentSpec")
+
+// ErrInvalidProjectID indicates that a generated project ID is not a valid GCP project ID
+var ErrInvalidProjectID = errors.New("InvalidProjectID")
+
+// ErrMismatchedProjectReference indicates that an existing ProjectReference does not belong to the claim
+var ErrMismatchedProjectReference = errors.New("MismatchedProjectReference")
+
+// ErrInvalidServicePerimeter indicates that the requested VPC Service Controls perimeter name is malformed
+var ErrInvalidServicePerimeter = errors.New("InvalidServicePerimeter")
+
+// ErrInvalidServiceAccountID indicates that the rendered service account ID violates GCP's constraints
+var ErrInvalidServiceAccountID = errors.New("InvalidServiceAccountID")
+
+// ErrProjectNotEmpty indicates that a project still holds resources and safe mode blocks its deletion
+var ErrProjectNotEmpty = errors.New("ProjectNotEmpty")
+
+// ErrProjectQuotaExhausted indicates that GCP refused project creation because the creation quota is exhausted
+var ErrProjectQuotaExhausted = errors.New("ProjectQuotaExhausted")
+
+// ErrTemplateMissing indicates that the ProjectReference template referenced by a claim does not exist
+var ErrTemplateMissing = errors.New("TemplateMissing")
+
+// ErrInvalidTag indicates that a requested resource manager tag does not resolve to an existing tag definition
+var ErrInvalidTag = errors.New("InvalidTag")
+
+// ErrInvalidComputeZone indicates that the requested default compute zone does not lie within the requested region
+var ErrInvalidComputeZone = errors.New("InvalidComputeZone")
+
+// ErrDependencyCycle indicates that the dependsOn references of a set of claims form a cycle that can never become Ready
+var ErrDependencyCycle = errors.New("DependencyCycle")
+
+// ErrProjectLimitReached indicates that the claim's legal entity already has the configured maximum number of projects
+var ErrProjectLimitReached = errors.New("ProjectLimitReached")
+
+// ErrReconcileTimeout indicates that a single reconcile pass did not finish within the configured deadline
+var ErrReconcileTimeout = errors.New("ReconcileTimeout")
+
+// ErrMissingRequiredLabels indicates that a claim does not carry every label key required by governance
+var ErrMissingRequiredLabels = errors.New("MissingRequiredLabels")
@@ -1,15 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: client.go
+// Source: pkg/gcpclient/client.go
 
 // Package gcpclient is a generated GoMock package.
 package gcpclient
 
 import (
-	reflect "reflect"
-
 	gomock "github.com/golang/mock/gomock"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
+	compute "google.golang.org/api/compute/v1"
 	iam "google.golang.org/api/iam/v1"
+	reflect "reflect"
+	time "time"
 )
 
 // MockClient is a mock of Client interface
@@ -108,6 +109,21 @@ func (mr *MockClientMockRecorder) DeleteServiceAccountKeys(serviceAccountEmail i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccountKeys", reflect.TypeOf((*MockClient)(nil).DeleteServiceAccountKeys), serviceAccountEmail)
 }
 
+// ListServiceAccounts mocks base method
+func (m *MockClient) ListServiceAccounts(projectID string) ([]*iam.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", projectID)
+	ret0, _ := ret[0].([]*iam.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts
+func (mr *MockClientMockRecorder) ListServiceAccounts(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockClient)(nil).ListServiceAccounts), projectID)
+}
+
 // GetIamPolicy mocks base method
 func (m *MockClient) GetIamPolicy(projectName string) (*cloudresourcemanager.Policy, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +214,148 @@ func (mr *MockClientMockRecorder) GetProject(projectID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockClient)(nil).GetProject), projectID)
 }
 
+// UpdateProjectDisplayName mocks base method
+func (m *MockClient) UpdateProjectDisplayName(projectID, displayName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectDisplayName", projectID, displayName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectDisplayName indicates an expected call of UpdateProjectDisplayName
+func (mr *MockClientMockRecorder) UpdateProjectDisplayName(projectID, displayName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectDisplayName", reflect.TypeOf((*MockClient)(nil).UpdateProjectDisplayName), projectID, displayName)
+}
+
+// SetProjectLabels mocks base method
+func (m *MockClient) SetProjectLabels(projectID string, labels map[string]string, removals []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectLabels", projectID, labels, removals)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectLabels indicates an expected call of SetProjectLabels
+func (mr *MockClientMockRecorder) SetProjectLabels(projectID, labels, removals interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectLabels", reflect.TypeOf((*MockClient)(nil).SetProjectLabels), projectID, labels, removals)
+}
+
+// MoveProject mocks base method
+func (m *MockClient) MoveProject(projectID, parentType, parentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProject", projectID, parentType, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveProject indicates an expected call of MoveProject
+func (mr *MockClientMockRecorder) MoveProject(projectID, parentType, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProject", reflect.TypeOf((*MockClient)(nil).MoveProject), projectID, parentType, parentID)
+}
+
+// TestParentIamPermissions mocks base method
+func (m *MockClient) TestParentIamPermissions(parentType, parentID string, permissions []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestParentIamPermissions", parentType, parentID, permissions)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestParentIamPermissions indicates an expected call of TestParentIamPermissions
+func (mr *MockClientMockRecorder) TestParentIamPermissions(parentType, parentID, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestParentIamPermissions", reflect.TypeOf((*MockClient)(nil).TestParentIamPermissions), parentType, parentID, permissions)
+}
+
+// LookupTagValue mocks base method
+func (m *MockClient) LookupTagValue(tagKey, tagValue string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupTagValue", tagKey, tagValue)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupTagValue indicates an expected call of LookupTagValue
+func (mr *MockClientMockRecorder) LookupTagValue(tagKey, tagValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupTagValue", reflect.TypeOf((*MockClient)(nil).LookupTagValue), tagKey, tagValue)
+}
+
+// CreateTagBinding mocks base method
+func (m *MockClient) CreateTagBinding(projectID, tagValueName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTagBinding", projectID, tagValueName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTagBinding indicates an expected call of CreateTagBinding
+func (mr *MockClientMockRecorder) CreateTagBinding(projectID, tagValueName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTagBinding", reflect.TypeOf((*MockClient)(nil).CreateTagBinding), projectID, tagValueName)
+}
+
+// DeleteTagBinding mocks base method
+func (m *MockClient) DeleteTagBinding(projectID, tagValueName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagBinding", projectID, tagValueName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTagBinding indicates an expected call of DeleteTagBinding
+func (mr *MockClientMockRecorder) DeleteTagBinding(projectID, tagValueName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagBinding", reflect.TypeOf((*MockClient)(nil).DeleteTagBinding), projectID, tagValueName)
+}
+
+// SetProjectOrgPolicy mocks base method
+func (m *MockClient) SetProjectOrgPolicy(projectID, constraint string, allowedValues []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectOrgPolicy", projectID, constraint, allowedValues)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectOrgPolicy indicates an expected call of SetProjectOrgPolicy
+func (mr *MockClientMockRecorder) SetProjectOrgPolicy(projectID, constraint, allowedValues interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectOrgPolicy", reflect.TypeOf((*MockClient)(nil).SetProjectOrgPolicy), projectID, constraint, allowedValues)
+}
+
+// SetProjectBooleanOrgPolicy mocks base method
+func (m *MockClient) SetProjectBooleanOrgPolicy(projectID, constraint string, enforced bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectBooleanOrgPolicy", projectID, constraint, enforced)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProjectBooleanOrgPolicy indicates an expected call of SetProjectBooleanOrgPolicy
+func (mr *MockClientMockRecorder) SetProjectBooleanOrgPolicy(projectID, constraint, enforced interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectBooleanOrgPolicy", reflect.TypeOf((*MockClient)(nil).SetProjectBooleanOrgPolicy), projectID, constraint, enforced)
+}
+
+// AddProjectToServicePerimeter mocks base method
+func (m *MockClient) AddProjectToServicePerimeter(perimeterName string, projectNumber int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProjectToServicePerimeter", perimeterName, projectNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProjectToServicePerimeter indicates an expected call of AddProjectToServicePerimeter
+func (mr *MockClientMockRecorder) AddProjectToServicePerimeter(perimeterName, projectNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProjectToServicePerimeter", reflect.TypeOf((*MockClient)(nil).AddProjectToServicePerimeter), perimeterName, projectNumber)
+}
+
 // EnableAPI mocks base method
 func (m *MockClient) EnableAPI(projectID, api string) error {
 	m.ctrl.T.Helper()
@@ -227,6 +385,21 @@ func (mr *MockClientMockRecorder) ListAPIs(projectID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIs", reflect.TypeOf((*MockClient)(nil).ListAPIs), projectID)
 }
 
+// GetServiceState mocks base method
+func (m *MockClient) GetServiceState(projectID, api string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceState", projectID, api)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceState indicates an expected call of GetServiceState
+func (mr *MockClientMockRecorder) GetServiceState(projectID, api interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceState", reflect.TypeOf((*MockClient)(nil).GetServiceState), projectID, api)
+}
+
 // CreateCloudBillingAccount mocks base method
 func (m *MockClient) CreateCloudBillingAccount(projectID, billingAccount string) error {
 	m.ctrl.T.Helper()
@@ -241,6 +414,21 @@ func (mr *MockClientMockRecorder) CreateCloudBillingAccount(projectID, billingAc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCloudBillingAccount", reflect.TypeOf((*MockClient)(nil).CreateCloudBillingAccount), projectID, billingAccount)
 }
 
+// GetCloudBillingAccount mocks base method
+func (m *MockClient) GetCloudBillingAccount(projectID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloudBillingAccount", projectID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloudBillingAccount indicates an expected call of GetCloudBillingAccount
+func (mr *MockClientMockRecorder) GetCloudBillingAccount(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudBillingAccount", reflect.TypeOf((*MockClient)(nil).GetCloudBillingAccount), projectID)
+}
+
 // ListAvailabilityZones mocks base method
 func (m *MockClient) ListAvailabilityZones(projectID, region string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -255,3 +443,187 @@ func (mr *MockClientMockRecorder) ListAvailabilityZones(projectID, region interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*MockClient)(nil).ListAvailabilityZones), projectID, region)
 }
+
+// DeleteDefaultNetwork mocks base method
+func (m *MockClient) DeleteDefaultNetwork(projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDefaultNetwork", projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDefaultNetwork indicates an expected call of DeleteDefaultNetwork
+func (mr *MockClientMockRecorder) DeleteDefaultNetwork(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDefaultNetwork", reflect.TypeOf((*MockClient)(nil).DeleteDefaultNetwork), projectID)
+}
+
+// ListRunningInstances mocks base method
+func (m *MockClient) ListRunningInstances(projectID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRunningInstances", projectID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRunningInstances indicates an expected call of ListRunningInstances
+func (mr *MockClientMockRecorder) ListRunningInstances(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRunningInstances", reflect.TypeOf((*MockClient)(nil).ListRunningInstances), projectID)
+}
+
+// CreateFirewallRule mocks base method
+func (m *MockClient) CreateFirewallRule(projectID string, firewall *compute.Firewall) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallRule", projectID, firewall)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFirewallRule indicates an expected call of CreateFirewallRule
+func (mr *MockClientMockRecorder) CreateFirewallRule(projectID, firewall interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockClient)(nil).CreateFirewallRule), projectID, firewall)
+}
+
+// SetComputeProjectMetadata mocks base method
+func (m *MockClient) SetComputeProjectMetadata(projectID string, metadata map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetComputeProjectMetadata", projectID, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetComputeProjectMetadata indicates an expected call of SetComputeProjectMetadata
+func (mr *MockClientMockRecorder) SetComputeProjectMetadata(projectID, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetComputeProjectMetadata", reflect.TypeOf((*MockClient)(nil).SetComputeProjectMetadata), projectID, metadata)
+}
+
+// CreateEssentialContact mocks base method
+func (m *MockClient) CreateEssentialContact(projectID, email string, categories []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEssentialContact", projectID, email, categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEssentialContact indicates an expected call of CreateEssentialContact
+func (mr *MockClientMockRecorder) CreateEssentialContact(projectID, email, categories interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEssentialContact", reflect.TypeOf((*MockClient)(nil).CreateEssentialContact), projectID, email, categories)
+}
+
+// CreateNotificationChannel mocks base method
+func (m *MockClient) CreateNotificationChannel(projectID, channelType, displayName string, labels map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationChannel", projectID, channelType, displayName, labels)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationChannel indicates an expected call of CreateNotificationChannel
+func (mr *MockClientMockRecorder) CreateNotificationChannel(projectID, channelType, displayName, labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationChannel", reflect.TypeOf((*MockClient)(nil).CreateNotificationChannel), projectID, channelType, displayName, labels)
+}
+
+// CreateKMSKeyRing mocks base method
+func (m *MockClient) CreateKMSKeyRing(projectID, location, keyRing string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKMSKeyRing", projectID, location, keyRing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKMSKeyRing indicates an expected call of CreateKMSKeyRing
+func (mr *MockClientMockRecorder) CreateKMSKeyRing(projectID, location, keyRing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKMSKeyRing", reflect.TypeOf((*MockClient)(nil).CreateKMSKeyRing), projectID, location, keyRing)
+}
+
+// CreateKMSCryptoKey mocks base method
+func (m *MockClient) CreateKMSCryptoKey(projectID, location, keyRing, key string, rotationPeriod time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKMSCryptoKey", projectID, location, keyRing, key, rotationPeriod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKMSCryptoKey indicates an expected call of CreateKMSCryptoKey
+func (mr *MockClientMockRecorder) CreateKMSCryptoKey(projectID, location, keyRing, key, rotationPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKMSCryptoKey", reflect.TypeOf((*MockClient)(nil).CreateKMSCryptoKey), projectID, location, keyRing, key, rotationPeriod)
+}
+
+// CreateWorkloadIdentityPool mocks base method
+func (m *MockClient) CreateWorkloadIdentityPool(projectID, poolID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkloadIdentityPool", projectID, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkloadIdentityPool indicates an expected call of CreateWorkloadIdentityPool
+func (mr *MockClientMockRecorder) CreateWorkloadIdentityPool(projectID, poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkloadIdentityPool", reflect.TypeOf((*MockClient)(nil).CreateWorkloadIdentityPool), projectID, poolID)
+}
+
+// CreateWorkloadIdentityProvider mocks base method
+func (m *MockClient) CreateWorkloadIdentityProvider(projectID, poolID, providerID, issuerURI string, allowedAudiences []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkloadIdentityProvider", projectID, poolID, providerID, issuerURI, allowedAudiences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkloadIdentityProvider indicates an expected call of CreateWorkloadIdentityProvider
+func (mr *MockClientMockRecorder) CreateWorkloadIdentityProvider(projectID, poolID, providerID, issuerURI, allowedAudiences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkloadIdentityProvider", reflect.TypeOf((*MockClient)(nil).CreateWorkloadIdentityProvider), projectID, poolID, providerID, issuerURI, allowedAudiences)
+}
+
+// DeleteWorkloadIdentityPool mocks base method
+func (m *MockClient) DeleteWorkloadIdentityPool(projectID, poolID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkloadIdentityPool", projectID, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkloadIdentityPool indicates an expected call of DeleteWorkloadIdentityPool
+func (mr *MockClientMockRecorder) DeleteWorkloadIdentityPool(projectID, poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkloadIdentityPool", reflect.TypeOf((*MockClient)(nil).DeleteWorkloadIdentityPool), projectID, poolID)
+}
+
+// BindWorkloadIdentityUser mocks base method
+func (m *MockClient) BindWorkloadIdentityUser(projectID, serviceAccountEmail, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindWorkloadIdentityUser", projectID, serviceAccountEmail, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindWorkloadIdentityUser indicates an expected call of BindWorkloadIdentityUser
+func (mr *MockClientMockRecorder) BindWorkloadIdentityUser(projectID, serviceAccountEmail, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindWorkloadIdentityUser", reflect.TypeOf((*MockClient)(nil).BindWorkloadIdentityUser), projectID, serviceAccountEmail, member)
+}
+
+// BindServiceAccountTokenCreator mocks base method
+func (m *MockClient) BindServiceAccountTokenCreator(projectID, serviceAccountEmail, member string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindServiceAccountTokenCreator", projectID, serviceAccountEmail, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindServiceAccountTokenCreator indicates an expected call of BindServiceAccountTokenCreator
+func (mr *MockClientMockRecorder) BindServiceAccountTokenCreator(projectID, serviceAccountEmail, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindServiceAccountTokenCreator", reflect.TypeOf((*MockClient)(nil).BindServiceAccountTokenCreator), projectID, serviceAccountEmail, member)
+}
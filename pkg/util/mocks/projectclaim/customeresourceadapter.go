@@ -34,6 +34,96 @@ func (m *MockCustomResourceAdapter) EXPECT() *MockCustomResourceAdapterMockRecor
 	return m.recorder
 }
 
+// EnsureAnnotationConditions mocks base method
+func (m *MockCustomResourceAdapter) EnsureAnnotationConditions() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureAnnotationConditions")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureAnnotationConditions indicates an expected call of EnsureAnnotationConditions
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureAnnotationConditions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureAnnotationConditions", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureAnnotationConditions))
+}
+
+// EnsureCredentialSecretCopied mocks base method
+func (m *MockCustomResourceAdapter) EnsureCredentialSecretCopied() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureCredentialSecretCopied")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureCredentialSecretCopied indicates an expected call of EnsureCredentialSecretCopied
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureCredentialSecretCopied() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureCredentialSecretCopied", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureCredentialSecretCopied))
+}
+
+// EnsureCredentialSecretPresent mocks base method
+func (m *MockCustomResourceAdapter) EnsureCredentialSecretPresent() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureCredentialSecretPresent")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureCredentialSecretPresent indicates an expected call of EnsureCredentialSecretPresent
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureCredentialSecretPresent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureCredentialSecretPresent", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureCredentialSecretPresent))
+}
+
+// EnsureDependenciesReady mocks base method
+func (m *MockCustomResourceAdapter) EnsureDependenciesReady() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDependenciesReady")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureDependenciesReady indicates an expected call of EnsureDependenciesReady
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureDependenciesReady() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDependenciesReady", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureDependenciesReady))
+}
+
+// EnsureDisplayNameValid mocks base method
+func (m *MockCustomResourceAdapter) EnsureDisplayNameValid() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDisplayNameValid")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureDisplayNameValid indicates an expected call of EnsureDisplayNameValid
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureDisplayNameValid() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDisplayNameValid", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureDisplayNameValid))
+}
+
+// EnsureDrainProcessed mocks base method
+func (m *MockCustomResourceAdapter) EnsureDrainProcessed() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDrainProcessed")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureDrainProcessed indicates an expected call of EnsureDrainProcessed
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureDrainProcessed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDrainProcessed", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureDrainProcessed))
+}
+
 // EnsureFinalizer mocks base method
 func (m *MockCustomResourceAdapter) EnsureFinalizer() (util.OperationResult, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +139,66 @@ func (mr *MockCustomResourceAdapterMockRecorder) EnsureFinalizer() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureFinalizer", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureFinalizer))
 }
 
+// EnsureLegalEntityBillingApplied mocks base method
+func (m *MockCustomResourceAdapter) EnsureLegalEntityBillingApplied() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureLegalEntityBillingApplied")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureLegalEntityBillingApplied indicates an expected call of EnsureLegalEntityBillingApplied
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureLegalEntityBillingApplied() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureLegalEntityBillingApplied", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureLegalEntityBillingApplied))
+}
+
+// EnsureLinkConsistency mocks base method
+func (m *MockCustomResourceAdapter) EnsureLinkConsistency() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureLinkConsistency")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureLinkConsistency indicates an expected call of EnsureLinkConsistency
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureLinkConsistency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureLinkConsistency", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureLinkConsistency))
+}
+
+// EnsureNamespaceActive mocks base method
+func (m *MockCustomResourceAdapter) EnsureNamespaceActive() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureNamespaceActive")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureNamespaceActive indicates an expected call of EnsureNamespaceActive
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureNamespaceActive() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureNamespaceActive", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureNamespaceActive))
+}
+
+// EnsureParentValid mocks base method
+func (m *MockCustomResourceAdapter) EnsureParentValid() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureParentValid")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureParentValid indicates an expected call of EnsureParentValid
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureParentValid() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureParentValid", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureParentValid))
+}
+
 // EnsureProjectClaimDeletionProcessed mocks base method
 func (m *MockCustomResourceAdapter) EnsureProjectClaimDeletionProcessed() (util.OperationResult, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +259,21 @@ func (mr *MockCustomResourceAdapterMockRecorder) EnsureProjectClaimStatePendingP
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureProjectClaimStatePendingProject", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureProjectClaimStatePendingProject))
 }
 
+// EnsureProjectLimitNotReached mocks base method
+func (m *MockCustomResourceAdapter) EnsureProjectLimitNotReached() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureProjectLimitNotReached")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureProjectLimitNotReached indicates an expected call of EnsureProjectLimitNotReached
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureProjectLimitNotReached() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureProjectLimitNotReached", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureProjectLimitNotReached))
+}
+
 // EnsureProjectReferenceExists mocks base method
 func (m *MockCustomResourceAdapter) EnsureProjectReferenceExists() (util.OperationResult, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +304,21 @@ func (mr *MockCustomResourceAdapterMockRecorder) EnsureProjectReferenceLink() *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureProjectReferenceLink", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureProjectReferenceLink))
 }
 
+// EnsureProjectReferenceSpecApplied mocks base method
+func (m *MockCustomResourceAdapter) EnsureProjectReferenceSpecApplied() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureProjectReferenceSpecApplied")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureProjectReferenceSpecApplied indicates an expected call of EnsureProjectReferenceSpecApplied
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureProjectReferenceSpecApplied() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureProjectReferenceSpecApplied", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureProjectReferenceSpecApplied))
+}
+
 // EnsureRegionSupported mocks base method
 func (m *MockCustomResourceAdapter) EnsureRegionSupported() (util.OperationResult, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +334,66 @@ func (mr *MockCustomResourceAdapterMockRecorder) EnsureRegionSupported() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRegionSupported", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureRegionSupported))
 }
 
+// EnsureRequiredLabelsPresent mocks base method
+func (m *MockCustomResourceAdapter) EnsureRequiredLabelsPresent() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureRequiredLabelsPresent")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureRequiredLabelsPresent indicates an expected call of EnsureRequiredLabelsPresent
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureRequiredLabelsPresent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRequiredLabelsPresent", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureRequiredLabelsPresent))
+}
+
+// EnsureServicePerimeterValid mocks base method
+func (m *MockCustomResourceAdapter) EnsureServicePerimeterValid() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureServicePerimeterValid")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureServicePerimeterValid indicates an expected call of EnsureServicePerimeterValid
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureServicePerimeterValid() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureServicePerimeterValid", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureServicePerimeterValid))
+}
+
+// EnsureSpecConsistent mocks base method
+func (m *MockCustomResourceAdapter) EnsureSpecConsistent() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureSpecConsistent")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureSpecConsistent indicates an expected call of EnsureSpecConsistent
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureSpecConsistent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureSpecConsistent", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureSpecConsistent))
+}
+
+// EnsureTemplateApplied mocks base method
+func (m *MockCustomResourceAdapter) EnsureTemplateApplied() (util.OperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureTemplateApplied")
+	ret0, _ := ret[0].(util.OperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureTemplateApplied indicates an expected call of EnsureTemplateApplied
+func (mr *MockCustomResourceAdapterMockRecorder) EnsureTemplateApplied() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureTemplateApplied", reflect.TypeOf((*MockCustomResourceAdapter)(nil).EnsureTemplateApplied))
+}
+
 // FinalizeProjectClaim mocks base method
 func (m *MockCustomResourceAdapter) FinalizeProjectClaim() (projectclaim.ObjectState, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +424,20 @@ func (mr *MockCustomResourceAdapterMockRecorder) ProjectReferenceExists() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectReferenceExists", reflect.TypeOf((*MockCustomResourceAdapter)(nil).ProjectReferenceExists))
 }
 
+// SetLastReconcileTime mocks base method
+func (m *MockCustomResourceAdapter) SetLastReconcileTime() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastReconcileTime")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastReconcileTime indicates an expected call of SetLastReconcileTime
+func (mr *MockCustomResourceAdapterMockRecorder) SetLastReconcileTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastReconcileTime", reflect.TypeOf((*MockCustomResourceAdapter)(nil).SetLastReconcileTime))
+}
+
 // SetProjectClaimCondition mocks base method
 func (m *MockCustomResourceAdapter) SetProjectClaimCondition(arg0 string, arg1 error) error {
 	m.ctrl.T.Helper()
@@ -4,20 +4,43 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"google.golang.org/api/cloudresourcemanager/v1"
 	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubetypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
+var log = logf.Log.WithName("util")
+
 const (
 	// secret information
 	gcpSecretName = "gcp"
 )
 
+// statusFallbackOnce makes sure the status subresource warning is only logged once
+var statusFallbackOnce sync.Once
+
+// UpdateStatus writes the status of obj via the status subresource.
+// If the subresource is not enabled on the CRD the apiserver answers with NotFound;
+// in that case a full update is used as fallback and a warning is logged once.
+func UpdateStatus(kubeClient client.Client, obj runtime.Object) error {
+	err := kubeClient.Status().Update(context.TODO(), obj)
+	if err != nil && kubeerrors.IsNotFound(err) {
+		statusFallbackOnce.Do(func() {
+			log.Info("status subresource does not seem to be enabled, falling back to full updates for status writes. Make sure the CRDs are deployed with 'subresources.status'.")
+		})
+		return kubeClient.Update(context.TODO(), obj)
+	}
+	return err
+}
+
 // SecretExists returns a boolean to the caller based on the secretName and namespace args.
 func SecretExists(kubeClient client.Client, secretName, namespace string) bool {
 	s := &corev1.Secret{}
@@ -38,8 +61,18 @@ func GetSecret(kubeClient client.Client, secretName, namespace string) (*corev1.
 	return s, nil
 }
 
+const (
+	// GCPProjectIDLabel labels the credentials secret with the GCP project it grants access to
+	GCPProjectIDLabel string = "gcp-project-id"
+	// ManagedByLabel marks resources written by this operator
+	ManagedByLabel string = "managed-by"
+	// ManagedByValue is the value resources written by this operator are labeled with
+	ManagedByValue string = "gcp-project-operator"
+)
+
 // NewGCPSecretCR returns a Secret CR formatted for GCP for use in projectreference controller.
-func NewGCPSecretCR(creds string, namespacedNamed kubetypes.NamespacedName) *corev1.Secret {
+// The secret is labeled with the project ID so downstream tooling can select it by label.
+func NewGCPSecretCR(creds string, projectID string, namespacedNamed kubetypes.NamespacedName) *corev1.Secret {
 	return &corev1.Secret{
 		Type: "Opaque",
 		TypeMeta: metav1.TypeMeta{
@@ -49,6 +82,10 @@ func NewGCPSecretCR(creds string, namespacedNamed kubetypes.NamespacedName) *cor
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      namespacedNamed.Name,
 			Namespace: namespacedNamed.Namespace,
+			Labels: map[string]string{
+				GCPProjectIDLabel: projectID,
+				ManagedByLabel:    ManagedByValue,
+			},
 		},
 		Data: map[string][]byte{
 			"osServiceAccount.json": []byte(creds),
@@ -4,14 +4,68 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/openshift/gcp-project-operator/pkg/util/errors"
+	"github.com/openshift/gcp-project-operator/pkg/util/mocks"
 	builders "github.com/openshift/gcp-project-operator/pkg/util/mocks/structs"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+func TestUpdateStatus(t *testing.T) {
+	notFound := kubeerrors.NewNotFound(schema.GroupResource{}, "the server could not find the requested resource")
+
+	tests := []struct {
+		name           string
+		statusErr      error
+		expectFallback bool
+		expectedErr    error
+	}{
+		{
+			name:      "Status subresource enabled",
+			statusErr: nil,
+		},
+		{
+			name:           "Status subresource not enabled",
+			statusErr:      notFound,
+			expectFallback: true,
+		},
+		{
+			name:        "Status update fails with other error",
+			statusErr:   fmt.Errorf("fake error"),
+			expectedErr: fmt.Errorf("fake error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockClient := mocks.NewMockClient(mockCtrl)
+			mockStatusWriter := mocks.NewMockStatusWriter(mockCtrl)
+			secret := builders.NewTestSecretBuilder("testName", "testNamespace", "testCreds").GetTestSecret()
+
+			mockClient.EXPECT().Status().Return(mockStatusWriter)
+			mockStatusWriter.EXPECT().Update(gomock.Any(), secret).Return(test.statusErr)
+			if test.expectFallback {
+				mockClient.EXPECT().Update(gomock.Any(), secret).Return(nil)
+			}
+
+			err := UpdateStatus(mockClient, secret)
+			if test.expectedErr != nil {
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSecretExists(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -109,6 +163,16 @@ func TestGetSecret(t *testing.T) {
 
 }
 
+func TestNewGCPSecretCR(t *testing.T) {
+	secret := NewGCPSecretCR("testCreds", "fake-project-id", types.NamespacedName{Name: "testName", Namespace: "testNamespace"})
+
+	assert.Equal(t, "testName", secret.Name)
+	assert.Equal(t, "testNamespace", secret.Namespace)
+	assert.Equal(t, []byte("testCreds"), secret.Data["osServiceAccount.json"])
+	assert.Equal(t, "fake-project-id", secret.Labels[GCPProjectIDLabel])
+	assert.Equal(t, ManagedByValue, secret.Labels[ManagedByLabel])
+}
+
 func TestGetGCPCredentialsFromSecret(t *testing.T) {
 	tests := []struct {
 		name            string